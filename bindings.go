@@ -3,20 +3,28 @@
 package sitter
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"os"
 	"runtime"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"unsafe"
 
 	"github.com/yourbase/treesitter/internal/lang"
 	C "github.com/yourbase/treesitter/internal/lib"
+	"github.com/yourbase/treesitter/internal/tlspool"
 	"modernc.org/libc"
 	"modernc.org/libc/sys/types"
 )
 
 // maintain a map of read functions that can be called from C
-var readFuncs = &readFuncsMap{funcs: make(map[int]ReadFunc)}
+var readFuncs = &readFuncsMap{funcs: make(map[int]*readFunc)}
+
+// maintain a map of loggers that can be called from C
+var logFuncs = &logFuncsMap{funcs: make(map[int]func(LogType, string))}
 
 // Parse is a shortcut for parsing bytes of source code,
 // returns root node
@@ -31,6 +39,15 @@ type Parser struct {
 	tls      *libc.TLS
 	isClosed bool
 	c        uintptr
+
+	// logFuncID is the ID of the logger registered in logFuncs, if any.
+	logFuncID int
+
+	// dotGraphs is the pipe set by PrintDotGraphs, if any.
+	dotGraphs *dotPipe
+
+	// lang is the language set by SetLanguage.
+	lang *Language
 }
 
 // NewParser creates new Parser
@@ -44,6 +61,7 @@ func NewParser() *Parser {
 // SetLanguage assignes Language to a parser
 func (p *Parser) SetLanguage(l *Language) {
 	C.Xts_parser_set_language(p.tls, p.c, lang.LanguagePtr(l))
+	p.lang = l
 }
 
 // ReadFunc is a function to retrieve a chunk of text at a given byte offset and (row, column) position
@@ -64,38 +82,105 @@ type Input struct {
 	Encoding InputEncoding
 }
 
+// unitSize returns the size in bytes of a code unit in the encoding.
+func (enc InputEncoding) unitSize() uint32 {
+	if enc == InputEncodingUTF16 {
+		return 2
+	}
+	return 1
+}
+
 // Parse produces new Tree from content using old tree
 func (p *Parser) Parse(oldTree *Tree, content []byte) *Tree {
+	return p.ParseEncoding(oldTree, content, InputEncodingUTF8)
+}
+
+// ParseEncoding produces new Tree from content in the given encoding using old tree.
+// UTF-16 content must be in the native byte order (little-endian on all supported platforms).
+func (p *Parser) ParseEncoding(oldTree *Tree, content []byte, encoding InputEncoding) *Tree {
+	return newTree(p.parseString(oldTree, content, encoding), encoding)
+}
+
+func (p *Parser) parseString(oldTree *Tree, content []byte, encoding InputEncoding) uintptr {
 	var BaseTree uintptr
 	if oldTree != nil {
 		BaseTree = oldTree.c
 	}
 
 	input := cbytes(p.tls, content)
-	BaseTree = C.Xts_parser_parse_string(p.tls, p.c, BaseTree, input, uint32(len(content)))
+	BaseTree = C.Xts_parser_parse_string_encoding(p.tls, p.c, BaseTree, input, uint32(len(content)), C.TSInputEncoding(encoding))
 	libc.Xfree(p.tls, input)
 
-	return p.newTree(BaseTree)
+	return BaseTree
+}
+
+// ErrParseHalted is returned by ParseContext when parsing halts before finishing
+// for a reason other than the context being done,
+// like exceeding the operation limit or not having a language set.
+var ErrParseHalted = errors.New("parse halted")
+
+// ParseContext produces new Tree from content using old tree,
+// halting the parse if ctx is done before it finishes.
+// In that case ParseContext returns ctx.Err(),
+// and calling a parse method again with the same content resumes the halted parse
+// unless Reset is called first.
+func (p *Parser) ParseContext(ctx context.Context, oldTree *Tree, content []byte) (*Tree, error) {
+	flag := libc.Xmalloc(p.tls, types.Size_t(unsafe.Sizeof(uintptr(0))))
+	defer libc.Xfree(p.tls, flag)
+	*(*uintptr)(unsafe.Pointer(flag)) = 0
+	if ctx.Err() != nil {
+		*(*uintptr)(unsafe.Pointer(flag)) = 1
+	}
+	C.Xts_parser_set_cancellation_flag(p.tls, p.c, flag)
+	defer C.Xts_parser_set_cancellation_flag(p.tls, p.c, 0)
+
+	if done := ctx.Done(); done != nil {
+		parseDone := make(chan struct{})
+		watchDone := make(chan struct{})
+		go func() {
+			defer close(watchDone)
+			select {
+			case <-done:
+				atomic.StoreUintptr((*uintptr)(unsafe.Pointer(flag)), 1)
+			case <-parseDone:
+			}
+		}()
+		defer func() {
+			close(parseDone)
+			<-watchDone
+		}()
+	}
+
+	BaseTree := p.parseString(oldTree, content, InputEncodingUTF8)
+	if BaseTree == 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return nil, ErrParseHalted
+	}
+	return newTree(BaseTree, InputEncodingUTF8), nil
 }
 
-// TODO(light)
 // ParseInput produces new Tree by reading from a callback defined in input
 // it is useful if your data is stored in specialized data structure
 // as it will avoid copying the data into []bytes
 // and faster access to edited part of the data
-//
-// func (p *Parser) ParseInput(oldTree *Tree, input Input) *Tree {
-// 	var BaseTree uintptr
-// 	if oldTree != nil {
-// 		BaseTree = oldTree.c
-// 	}
+func (p *Parser) ParseInput(oldTree *Tree, input Input) *Tree {
+	var BaseTree uintptr
+	if oldTree != nil {
+		BaseTree = oldTree.c
+	}
 
-// 	funcID := readFuncs.register(input.Read)
-// 	BaseTree = C.call_ts_parser_parse(p.c, BaseTree, C.int(funcID), C.TSInputEncoding(input.Encoding))
-// 	readFuncs.unregister(funcID)
+	funcID := readFuncs.register(input.Read)
+	BaseTree = C.Xts_parser_parse(p.tls, p.c, BaseTree, C.TSInput{
+		Payload:  uintptr(funcID),
+		Read:     readFuncPtr,
+		Encoding: C.TSInputEncoding(input.Encoding),
+	})
+	readFuncs.unregister(p.tls, funcID)
 
-// 	return p.newTree(BaseTree)
-// }
+	return newTree(BaseTree, input.Encoding)
+}
 
 // OperationLimit returns the duration in microseconds that parsing is allowed to take
 func (p *Parser) OperationLimit() int {
@@ -136,18 +221,62 @@ func (p *Parser) SetIncludedRanges(ranges []Range) {
 	C.Xts_parser_set_included_ranges(p.tls, p.c, cranges, uint32(len(ranges)))
 }
 
+// LogType is the kind of event reported to a parser's logger.
+type LogType int
+
+const (
+	LogTypeParse LogType = iota
+	LogTypeLex
+)
+
+var logTypeNames = []string{
+	"Parse",
+	"Lex",
+}
+
+func (t LogType) String() string {
+	if t < 0 || int(t) >= len(logTypeNames) {
+		return fmt.Sprintf("LogType(%d)", int(t))
+	}
+	return logTypeNames[t]
+}
+
+// SetLogger sets the function the parser calls to report parse and lex events.
+// Passing nil stops logging.
+func (p *Parser) SetLogger(logger func(LogType, string)) {
+	if p.logFuncID != 0 {
+		logFuncs.unregister(p.logFuncID)
+		p.logFuncID = 0
+	}
+	if logger == nil {
+		C.Xts_parser_set_logger(p.tls, p.c, C.TSLogger{})
+		return
+	}
+
+	p.logFuncID = logFuncs.register(logger)
+	C.Xts_parser_set_logger(p.tls, p.c, C.TSLogger{
+		Payload: uintptr(p.logFuncID),
+		Log:     logFuncPtr,
+	})
+}
+
 // Debug enables debug output to stderr
 func (p *Parser) Debug() {
-	panic("TODO(light)")
-	// logger := C.stderr_logger_new(true)
-	// C.Xts_parser_set_logger(p.c, logger)
+	p.SetLogger(func(t LogType, msg string) {
+		if t == LogTypeLex {
+			msg = "  " + msg
+		}
+		fmt.Fprintln(os.Stderr, msg)
+	})
 }
 
 // Close should be called to ensure that all the memory used by the parse is freed.
 func (p *Parser) Close() {
 	if !p.isClosed {
+		p.stopDotGraphs()
 		C.Xts_parser_delete(p.tls, p.c)
 		p.tls.Close()
+		logFuncs.unregister(p.logFuncID)
 	}
 
 	p.isClosed = true
@@ -170,43 +299,60 @@ type Range struct {
 // we can workaround it using separate object
 // for details see: https://github.com/golang/go/issues/7358#issuecomment-66091558
 type BaseTree struct {
-	tls      *libc.TLS
 	c        uintptr
 	isClosed bool
 }
 
 // newTree creates a new tree object from a C pointer. The function will set a finalizer for the object,
 // thus no free is needed for it.
-func (p *Parser) newTree(c uintptr) *Tree {
-	base := &BaseTree{tls: p.tls, c: c}
+func newTree(c uintptr, encoding InputEncoding) *Tree {
+	base := &BaseTree{c: c}
 	runtime.SetFinalizer(base, (*BaseTree).Close)
 
-	newTree := &Tree{p: p, BaseTree: base, cache: make(map[C.TSNode]*Node)}
+	newTree := &Tree{BaseTree: base, encoding: encoding}
 	return newTree
 }
 
 // Tree represents the syntax tree of an entire source code file
-// Note: Tree instances are not thread safe;
-// you must copy a tree if you want to use it on multiple threads simultaneously.
+// Note: reading a tree, like navigating its nodes or running queries on it,
+// is safe from multiple goroutines simultaneously.
+// Editing or closing a tree is not; you must copy a tree if you want to edit it
+// while other goroutines use it.
 type Tree struct {
 	*BaseTree
 
-	// p is a pointer to a Parser that produced the Tree. Only used to keep Parser alive.
-	// Otherwise Parser may be GC'ed (and deleted by the finalizer) while some Tree objects are still in use.
-	p *Parser
+	// encoding of the text the tree was parsed from
+	encoding InputEncoding
 
-	// most probably better save node.id
-	cache map[C.TSNode]*Node
+	// cacheMu guards cache and oldCache
+	cacheMu sync.RWMutex
+	// cache holds recently used nodes so that repeated lookups return the same *Node.
+	// Once it holds nodeCacheSize nodes, it becomes oldCache and a new cache is started,
+	// which bounds memory use when walking large trees.
+	cache    map[C.TSNode]*Node
+	oldCache map[C.TSNode]*Node
 }
 
+// nodeCacheSize is the number of nodes after which a tree's node cache is rotated.
+const nodeCacheSize = 1 << 14
+
 // Copy returns a new copy of a tree
 func (t *Tree) Copy() *Tree {
-	return t.p.newTree(C.Xts_tree_copy(t.p.tls, t.c))
+	tls := getTLS()
+	defer putTLS(tls)
+	return newTree(C.Xts_tree_copy(tls, t.c), t.encoding)
+}
+
+// Encoding returns the encoding of the text the tree was parsed from.
+func (t *Tree) Encoding() InputEncoding {
+	return t.encoding
 }
 
 // RootNode returns root node of a tree
 func (t *Tree) RootNode() *Node {
-	ptr := C.Xts_tree_root_node(t.p.tls, t.c)
+	tls := getTLS()
+	defer putTLS(tls)
+	ptr := C.Xts_tree_root_node(tls, t.c)
 	return t.cachedNode(ptr)
 }
 
@@ -215,19 +361,51 @@ func (t *Tree) cachedNode(ptr C.TSNode) *Node {
 		return nil
 	}
 
-	if n, ok := t.cache[ptr]; ok {
+	// Hits only need a read lock, so that goroutines reading a tree don't serialize.
+	t.cacheMu.RLock()
+	n, ok := t.cache[ptr]
+	t.cacheMu.RUnlock()
+	if ok {
 		return n
 	}
 
-	n := &Node{ptr, t}
+	t.cacheMu.Lock()
+	defer t.cacheMu.Unlock()
+	if n, ok := t.cache[ptr]; ok {
+		return n
+	}
+	n, ok = t.oldCache[ptr]
+	if !ok {
+		n = &Node{ptr, t}
+	}
+	if len(t.cache) >= nodeCacheSize {
+		t.oldCache = t.cache
+		t.cache = nil
+	}
+	if t.cache == nil {
+		t.cache = make(map[C.TSNode]*Node)
+	}
 	t.cache[ptr] = n
 	return n
 }
 
+// DropNodeCache releases the nodes the tree has cached.
+// Nodes obtained earlier remain valid, but looking up the same node again
+// may return a different *Node; use Node.Equal to compare nodes.
+func (t *Tree) DropNodeCache() {
+	t.cacheMu.Lock()
+	defer t.cacheMu.Unlock()
+
+	t.cache = nil
+	t.oldCache = nil
+}
+
 // Close should be called to ensure that all the memory used by the tree is freed.
 func (t *BaseTree) Close() {
 	if !t.isClosed {
-		C.Xts_tree_delete(t.tls, t.c)
+		tls := getTLS()
+		C.Xts_tree_delete(tls, t.c)
+		putTLS(tls)
 	}
 
 	t.isClosed = true
@@ -257,17 +435,110 @@ func (i EditInput) c(tls *libc.TLS) uintptr {
 		Column: uint32(i.OldEndPoint.Column),
 	}
 	c.New_end_point = C.TSPoint{
-		Row:    uint32(i.OldEndPoint.Row),
-		Column: uint32(i.OldEndPoint.Column),
+		Row:    uint32(i.NewEndPoint.Row),
+		Column: uint32(i.NewEndPoint.Column),
 	}
 	return ptr
 }
 
 // Edit the syntax tree to keep it in sync with source code that has been edited.
 func (t *Tree) Edit(i EditInput) {
-	ic := i.c(t.p.tls)
-	defer libc.Xfree(t.p.tls, ic)
-	C.Xts_tree_edit(t.p.tls, t.c, ic)
+	tls := getTLS()
+	defer putTLS(tls)
+	ic := i.c(tls)
+	defer libc.Xfree(tls, ic)
+	C.Xts_tree_edit(tls, t.c, ic)
+}
+
+// ApplyTextEdit replaces src[start:oldEnd] with replacement,
+// edits the tree to keep it in sync and returns the new source code.
+// src must be the source code the tree currently reflects,
+// in the encoding the tree was parsed from.
+// ApplyTextEdit panics if the offsets are out of order, out of range,
+// or not on a code unit boundary.
+func (t *Tree) ApplyTextEdit(src []byte, start, oldEnd uint32, replacement []byte) (newSrc []byte) {
+	newSrc, e := TextEdit(src, start, oldEnd, replacement, t.encoding)
+	t.Edit(e)
+	return newSrc
+}
+
+// TextEdit replaces src[start:oldEnd] with replacement in source code of the given encoding,
+// and returns the new source code along with the edit to pass to Tree.Edit.
+// It panics like Tree.ApplyTextEdit.
+func TextEdit(src []byte, start, oldEnd uint32, replacement []byte, encoding InputEncoding) (newSrc []byte, e EditInput) {
+	unit := encoding.unitSize()
+	if start > oldEnd || int(oldEnd) > len(src) {
+		panic(fmt.Sprintf("sitter: text edit [%d:%d] out of range for %d bytes of source", start, oldEnd, len(src)))
+	}
+	if start%unit != 0 || oldEnd%unit != 0 || uint32(len(replacement))%unit != 0 {
+		panic(fmt.Sprintf("sitter: text edit [%d:%d] with %d bytes of replacement is not on a code unit boundary", start, oldEnd, len(replacement)))
+	}
+
+	newEnd := start + uint32(len(replacement))
+	newSrc = make([]byte, 0, len(src)-int(oldEnd-start)+len(replacement))
+	newSrc = append(newSrc, src[:start]...)
+	newSrc = append(newSrc, replacement...)
+	newSrc = append(newSrc, src[oldEnd:]...)
+
+	startPoint := advancePoint(Point{}, src[:start], encoding)
+	return newSrc, EditInput{
+		StartIndex:  start,
+		OldEndIndex: oldEnd,
+		NewEndIndex: newEnd,
+		StartPoint:  startPoint,
+		OldEndPoint: advancePoint(startPoint, src[start:oldEnd], encoding),
+		NewEndPoint: advancePoint(startPoint, replacement, encoding),
+	}
+}
+
+// advancePoint returns the position after text when text starts at p.
+// Like tree-sitter, columns are counted in bytes.
+func advancePoint(p Point, text []byte, encoding InputEncoding) Point {
+	unit := int(encoding.unitSize())
+	for i := 0; i+unit <= len(text); i += unit {
+		newline := text[i] == '\n'
+		if unit == 2 {
+			newline = newline && text[i+1] == 0
+		}
+		if newline {
+			p.Row++
+			p.Column = 0
+		} else {
+			p.Column += uint32(unit)
+		}
+	}
+	return p
+}
+
+// ChangedRanges compares the tree to a new tree and returns the ranges whose syntactic structure has changed.
+// The tree must have been edited to match the new tree's source code,
+// and the new tree must have been parsed using the edited tree as the old tree.
+func (t *Tree) ChangedRanges(other *Tree) []Range {
+	tls := getTLS()
+	defer putTLS(tls)
+	countPtr := libc.Xmalloc(tls, types.Size_t(unsafe.Sizeof(uint32(0))))
+	defer libc.Xfree(tls, countPtr)
+	cranges := C.Xts_tree_get_changed_ranges(tls, t.c, other.c, countPtr)
+	defer libc.Xfree(tls, cranges)
+
+	count := int(*(*uint32)(unsafe.Pointer(countPtr)))
+	ranges := make([]Range, 0, count)
+	for i := 0; i < count; i++ {
+		r := (*C.TSRange)(unsafe.Pointer(cranges + uintptr(i)*unsafe.Sizeof(C.TSRange{})))
+		ranges = append(ranges, Range{
+			StartPoint: Point{
+				Row:    uint32(r.Start_point.Row),
+				Column: uint32(r.Start_point.Column),
+			},
+			EndPoint: Point{
+				Row:    uint32(r.End_point.Row),
+				Column: uint32(r.End_point.Column),
+			},
+			StartByte: uint32(r.Start_byte),
+			EndByte:   uint32(r.End_byte),
+		})
+	}
+	return ranges
 }
 
 // Language defines how to parse a particular programming language
@@ -276,6 +547,8 @@ type Language = lang.Language
 // Node represents a single node in the syntax tree
 // It tracks its start and end positions in the source code,
 // as well as its relation to other nodes like its parent, siblings and children.
+// Trees cache recently used nodes, so looking up the same node twice usually
+// returns the same *Node, but use Equal to check whether two nodes are the same.
 type Node struct {
 	c C.TSNode
 	t *Tree // keep pointer on tree because node is valid only as long as tree is
@@ -283,8 +556,20 @@ type Node struct {
 
 type Symbol = C.TSSymbol
 
+// FieldID identifies a field in a Language. Zero means no field.
+type FieldID = C.TSFieldId
+
 type SymbolType = lang.SymbolType
 
+// SymbolMetadata describes how nodes of a Symbol appear in syntax trees.
+type SymbolMetadata = lang.SymbolMetadata
+
+// NodeType is a type of node that can appear in a Language's syntax trees.
+type NodeType = lang.NodeType
+
+// Field is a field of a Language, which names a child of a node.
+type Field = lang.Field
+
 const (
 	SymbolTypeRegular SymbolType = iota
 	SymbolTypeAnonymous
@@ -293,17 +578,23 @@ const (
 
 // StartByte returns the node's start byte.
 func (n Node) StartByte() uint32 {
-	return uint32(C.Xts_node_start_byte(n.t.tls, n.c))
+	tls := getTLS()
+	defer putTLS(tls)
+	return uint32(C.Xts_node_start_byte(tls, n.c))
 }
 
 // EndByte returns the node's end byte.
 func (n Node) EndByte() uint32 {
-	return uint32(C.Xts_node_end_byte(n.t.tls, n.c))
+	tls := getTLS()
+	defer putTLS(tls)
+	return uint32(C.Xts_node_end_byte(tls, n.c))
 }
 
 // StartPoint returns the node's start position in terms of rows and columns.
 func (n Node) StartPoint() Point {
-	p := C.Xts_node_start_point(n.t.tls, n.c)
+	tls := getTLS()
+	defer putTLS(tls)
+	p := C.Xts_node_start_point(tls, n.c)
 	return Point{
 		Row:    uint32(p.Row),
 		Column: uint32(p.Column),
@@ -312,132 +603,278 @@ func (n Node) StartPoint() Point {
 
 // EndPoint returns the node's end position in terms of rows and columns.
 func (n Node) EndPoint() Point {
-	p := C.Xts_node_end_point(n.t.tls, n.c)
+	tls := getTLS()
+	defer putTLS(tls)
+	p := C.Xts_node_end_point(tls, n.c)
 	return Point{
 		Row:    uint32(p.Row),
 		Column: uint32(p.Column),
 	}
 }
 
+// StartOffset returns the node's start offset in code units of the tree's encoding:
+// bytes for UTF-8 and 16-bit code units for UTF-16.
+func (n Node) StartOffset() uint32 {
+	return n.StartByte() / n.t.encoding.unitSize()
+}
+
+// EndOffset returns the node's end offset in code units of the tree's encoding.
+func (n Node) EndOffset() uint32 {
+	return n.EndByte() / n.t.encoding.unitSize()
+}
+
+// StartPosition returns the node's start position
+// with the column in code units of the tree's encoding.
+func (n Node) StartPosition() Point {
+	p := n.StartPoint()
+	p.Column /= n.t.encoding.unitSize()
+	return p
+}
+
+// EndPosition returns the node's end position
+// with the column in code units of the tree's encoding.
+func (n Node) EndPosition() Point {
+	p := n.EndPoint()
+	p.Column /= n.t.encoding.unitSize()
+	return p
+}
+
 // Symbol returns the node's type as a Symbol.
 func (n Node) Symbol() Symbol {
-	return C.Xts_node_symbol(n.t.tls, n.c)
+	tls := getTLS()
+	defer putTLS(tls)
+	return C.Xts_node_symbol(tls, n.c)
 }
 
 // Type returns the node's type as a string.
 func (n Node) Type() string {
-	return libc.GoString(C.Xts_node_type(n.t.tls, n.c))
+	tls := getTLS()
+	defer putTLS(tls)
+	return libc.GoString(C.Xts_node_type(tls, n.c))
 }
 
 // String returns an S-expression representing the node as a string.
 func (n Node) String() string {
-	ptr := C.Xts_node_string(n.t.tls, n.c)
-	defer libc.Xfree(n.t.tls, ptr)
+	tls := getTLS()
+	defer putTLS(tls)
+	ptr := C.Xts_node_string(tls, n.c)
+	defer libc.Xfree(tls, ptr)
 	return libc.GoString(ptr)
 }
 
 // Equal checks if two nodes are identical.
 func (n Node) Equal(other *Node) bool {
-	return C.Xts_node_eq(n.t.tls, n.c, other.c) != 0
+	tls := getTLS()
+	defer putTLS(tls)
+	return C.Xts_node_eq(tls, n.c, other.c) != 0
 }
 
 // IsNull checks if the node is null.
 func (n Node) IsNull() bool {
-	return C.Xts_node_is_null(n.t.tls, n.c) != 0
+	tls := getTLS()
+	defer putTLS(tls)
+	return C.Xts_node_is_null(tls, n.c) != 0
 }
 
 // IsNamed checks if the node is *named*.
 // Named nodes correspond to named rules in the grammar,
 // whereas *anonymous* nodes correspond to string literals in the grammar.
 func (n Node) IsNamed() bool {
-	return C.Xts_node_is_named(n.t.tls, n.c) != 0
+	tls := getTLS()
+	defer putTLS(tls)
+	return C.Xts_node_is_named(tls, n.c) != 0
 }
 
 // IsMissing checks if the node is *missing*.
 // Missing nodes are inserted by the parser in order to recover from certain kinds of syntax errors.
 func (n Node) IsMissing() bool {
-	return C.Xts_node_is_missing(n.t.tls, n.c) != 0
+	tls := getTLS()
+	defer putTLS(tls)
+	return C.Xts_node_is_missing(tls, n.c) != 0
+}
+
+// IsExtra checks if the node is *extra*.
+// Extra nodes represent things like comments, which are not required by the grammar,
+// but can appear anywhere.
+func (n Node) IsExtra() bool {
+	tls := getTLS()
+	defer putTLS(tls)
+	return C.Xts_node_is_extra(tls, n.c) != 0
 }
 
 // HasChanges checks if a syntax node has been edited.
 func (n Node) HasChanges() bool {
-	return C.Xts_node_has_changes(n.t.tls, n.c) != 0
+	tls := getTLS()
+	defer putTLS(tls)
+	return C.Xts_node_has_changes(tls, n.c) != 0
 }
 
 // HasError check if the node is a syntax error or contains any syntax errors.
 func (n Node) HasError() bool {
-	return C.Xts_node_has_error(n.t.tls, n.c) != 0
+	tls := getTLS()
+	defer putTLS(tls)
+	return C.Xts_node_has_error(tls, n.c) != 0
 }
 
 // Parent returns the node's immediate parent.
 func (n Node) Parent() *Node {
-	nn := C.Xts_node_parent(n.t.tls, n.c)
+	tls := getTLS()
+	defer putTLS(tls)
+	nn := C.Xts_node_parent(tls, n.c)
 	return n.t.cachedNode(nn)
 }
 
 // Child returns the node's child at the given index, where zero represents the first child.
 func (n Node) Child(idx int) *Node {
-	nn := C.Xts_node_child(n.t.tls, n.c, uint32(idx))
+	tls := getTLS()
+	defer putTLS(tls)
+	nn := C.Xts_node_child(tls, n.c, uint32(idx))
 	return n.t.cachedNode(nn)
 }
 
 // NamedChild returns the node's *named* child at the given index.
 func (n Node) NamedChild(idx int) *Node {
-	nn := C.Xts_node_named_child(n.t.tls, n.c, uint32(idx))
+	tls := getTLS()
+	defer putTLS(tls)
+	nn := C.Xts_node_named_child(tls, n.c, uint32(idx))
 	return n.t.cachedNode(nn)
 }
 
 // ChildCount returns the node's number of children.
 func (n Node) ChildCount() uint32 {
-	return uint32(C.Xts_node_child_count(n.t.tls, n.c))
+	tls := getTLS()
+	defer putTLS(tls)
+	return uint32(C.Xts_node_child_count(tls, n.c))
 }
 
 // NamedChildCount returns the node's number of *named* children.
 func (n Node) NamedChildCount() uint32 {
-	return uint32(C.Xts_node_named_child_count(n.t.tls, n.c))
+	tls := getTLS()
+	defer putTLS(tls)
+	return uint32(C.Xts_node_named_child_count(tls, n.c))
 }
 
 // ChildByFieldName returns the node's child with the given field name.
 func (n Node) ChildByFieldName(name string) *Node {
+	tls := getTLS()
+	defer putTLS(tls)
 	str, _ := libc.CString(name)
-	defer libc.Xfree(n.t.tls, str)
-	nn := C.Xts_node_child_by_field_name(n.t.tls, n.c, str, uint32(len(name)))
+	defer libc.Xfree(tls, str)
+	nn := C.Xts_node_child_by_field_name(tls, n.c, str, uint32(len(name)))
+	return n.t.cachedNode(nn)
+}
+
+// ChildByFieldID returns the node's child with the given field ID.
+func (n Node) ChildByFieldID(id FieldID) *Node {
+	tls := getTLS()
+	defer putTLS(tls)
+	nn := C.Xts_node_child_by_field_id(tls, n.c, id)
+	return n.t.cachedNode(nn)
+}
+
+// FieldNameForChild returns the field name of the node's child at the given index,
+// or an empty string if the child doesn't have a field.
+func (n Node) FieldNameForChild(idx int) string {
+	tls := getTLS()
+	defer putTLS(tls)
+	return libc.GoString(C.Xts_node_field_name_for_child(tls, n.c, uint32(idx)))
+}
+
+// FirstChildForByte returns the node's first child that extends beyond the given byte offset.
+func (n Node) FirstChildForByte(b uint32) *Node {
+	tls := getTLS()
+	defer putTLS(tls)
+	nn := C.Xts_node_first_child_for_byte(tls, n.c, b)
+	return n.t.cachedNode(nn)
+}
+
+// FirstNamedChildForByte returns the node's first *named* child that extends beyond the given byte offset.
+func (n Node) FirstNamedChildForByte(b uint32) *Node {
+	tls := getTLS()
+	defer putTLS(tls)
+	nn := C.Xts_node_first_named_child_for_byte(tls, n.c, b)
+	return n.t.cachedNode(nn)
+}
+
+// DescendantForByteRange returns the smallest node within this node
+// that spans the given range of bytes.
+func (n Node) DescendantForByteRange(start, end uint32) *Node {
+	tls := getTLS()
+	defer putTLS(tls)
+	nn := C.Xts_node_descendant_for_byte_range(tls, n.c, start, end)
+	return n.t.cachedNode(nn)
+}
+
+// NamedDescendantForByteRange returns the smallest *named* node within this node
+// that spans the given range of bytes.
+func (n Node) NamedDescendantForByteRange(start, end uint32) *Node {
+	tls := getTLS()
+	defer putTLS(tls)
+	nn := C.Xts_node_named_descendant_for_byte_range(tls, n.c, start, end)
+	return n.t.cachedNode(nn)
+}
+
+// DescendantForPointRange returns the smallest node within this node
+// that spans the given range of positions.
+func (n Node) DescendantForPointRange(start, end Point) *Node {
+	tls := getTLS()
+	defer putTLS(tls)
+	nn := C.Xts_node_descendant_for_point_range(tls, n.c, pointToC(start), pointToC(end))
+	return n.t.cachedNode(nn)
+}
+
+// NamedDescendantForPointRange returns the smallest *named* node within this node
+// that spans the given range of positions.
+func (n Node) NamedDescendantForPointRange(start, end Point) *Node {
+	tls := getTLS()
+	defer putTLS(tls)
+	nn := C.Xts_node_named_descendant_for_point_range(tls, n.c, pointToC(start), pointToC(end))
 	return n.t.cachedNode(nn)
 }
 
 // NextSibling returns the node's next sibling.
 func (n Node) NextSibling() *Node {
-	nn := C.Xts_node_next_sibling(n.t.tls, n.c)
+	tls := getTLS()
+	defer putTLS(tls)
+	nn := C.Xts_node_next_sibling(tls, n.c)
 	return n.t.cachedNode(nn)
 }
 
 // NextNamedSibling returns the node's next *named* sibling.
 func (n Node) NextNamedSibling() *Node {
-	nn := C.Xts_node_next_named_sibling(n.t.tls, n.c)
+	tls := getTLS()
+	defer putTLS(tls)
+	nn := C.Xts_node_next_named_sibling(tls, n.c)
 	return n.t.cachedNode(nn)
 }
 
 // PrevSibling returns the node's previous sibling.
 func (n Node) PrevSibling() *Node {
-	nn := C.Xts_node_prev_sibling(n.t.tls, n.c)
+	tls := getTLS()
+	defer putTLS(tls)
+	nn := C.Xts_node_prev_sibling(tls, n.c)
 	return n.t.cachedNode(nn)
 }
 
 // PrevNamedSibling returns the node's previous *named* sibling.
 func (n Node) PrevNamedSibling() *Node {
-	nn := C.Xts_node_prev_named_sibling(n.t.tls, n.c)
+	tls := getTLS()
+	defer putTLS(tls)
+	nn := C.Xts_node_prev_named_sibling(tls, n.c)
 	return n.t.cachedNode(nn)
 }
 
 // Edit the node to keep it in-sync with source code that has been edited.
 func (n *Node) Edit(i EditInput) {
-	ic := i.c(n.t.tls)
-	defer libc.Xfree(n.t.tls, ic)
-	clonePtr := libc.Xmalloc(n.t.tls, types.Size_t(unsafe.Sizeof(C.TSNode{})))
-	defer libc.Xfree(n.t.tls, clonePtr)
+	tls := getTLS()
+	defer putTLS(tls)
+	ic := i.c(tls)
+	defer libc.Xfree(tls, ic)
+	clonePtr := libc.Xmalloc(tls, types.Size_t(unsafe.Sizeof(C.TSNode{})))
+	defer libc.Xfree(tls, clonePtr)
 	clone := (*C.TSNode)(unsafe.Pointer(clonePtr))
 	*clone = n.c
-	C.Xts_node_edit(n.t.tls, clonePtr, ic)
+	C.Xts_node_edit(tls, clonePtr, ic)
 	n.c = *clone
 }
 
@@ -446,22 +883,34 @@ func (n Node) Content(input []byte) string {
 	return string(input[n.StartByte():n.EndByte()])
 }
 
+// ContentUTF16 returns node's source code from UTF-16 input
+// that was parsed with InputEncodingUTF16.
+func (n Node) ContentUTF16(input []uint16) []uint16 {
+	return input[n.StartOffset():n.EndOffset()]
+}
+
 // TreeCursor allows you to walk a syntax tree more efficiently than is
 // possible using the `Node` functions. It is a mutable object that is always
 // on a certain syntax node, and can be moved imperatively to different nodes.
 type TreeCursor struct {
-	c uintptr
-	t *Tree
+	tls *libc.TLS
+	c   uintptr
+	t   *Tree
+
+	// depth of the current node relative to the node the cursor was created or reset at
+	depth uint32
 
 	isClosed bool
 }
 
 // NewTreeCursor creates a new tree cursor starting from the given node.
 func NewTreeCursor(n *Node) *TreeCursor {
-	cc := C.Xts_tree_cursor_new(n.t.tls, n.c)
+	tls := libc.NewTLS()
+	cc := C.Xts_tree_cursor_new(tls, n.c)
 	c := &TreeCursor{
-		c: libc.Xmalloc(n.t.tls, types.Size_t(unsafe.Sizeof(C.TSTreeCursor{}))),
-		t: n.t,
+		tls: tls,
+		c:   libc.Xmalloc(tls, types.Size_t(unsafe.Sizeof(C.TSTreeCursor{}))),
+		t:   n.t,
 	}
 	*(*C.TSTreeCursor)(unsafe.Pointer(c.c)) = cc
 
@@ -469,12 +918,30 @@ func NewTreeCursor(n *Node) *TreeCursor {
 	return c
 }
 
+// Copy returns a new tree cursor on the same node as c,
+// which can be moved independently from c.
+func (c *TreeCursor) Copy() *TreeCursor {
+	tls := libc.NewTLS()
+	cc := C.Xts_tree_cursor_copy(tls, c.c)
+	cp := &TreeCursor{
+		tls:   tls,
+		c:     libc.Xmalloc(tls, types.Size_t(unsafe.Sizeof(C.TSTreeCursor{}))),
+		t:     c.t,
+		depth: c.depth,
+	}
+	*(*C.TSTreeCursor)(unsafe.Pointer(cp.c)) = cc
+
+	runtime.SetFinalizer(cp, (*TreeCursor).Close)
+	return cp
+}
+
 // Close should be called to ensure that all the memory used by the tree cursor
 // is freed.
 func (c *TreeCursor) Close() {
 	if !c.isClosed {
-		C.Xts_tree_cursor_delete(c.t.tls, c.c)
-		libc.Xfree(c.t.tls, c.c)
+		C.Xts_tree_cursor_delete(c.tls, c.c)
+		libc.Xfree(c.tls, c.c)
+		c.tls.Close()
 	}
 
 	c.isClosed = true
@@ -483,12 +950,19 @@ func (c *TreeCursor) Close() {
 // Reset re-initializes a tree cursor to start at a different node.
 func (c *TreeCursor) Reset(n *Node) {
 	c.t = n.t
-	C.Xts_tree_cursor_reset(c.t.tls, c.c, n.c)
+	c.depth = 0
+	C.Xts_tree_cursor_reset(c.tls, c.c, n.c)
+}
+
+// Depth returns the depth of the cursor's current node
+// relative to the node the cursor was created or last reset at.
+func (c *TreeCursor) Depth() uint32 {
+	return c.depth
 }
 
 // CurrentNode of the tree cursor.
 func (c *TreeCursor) CurrentNode() *Node {
-	n := C.Xts_tree_cursor_current_node(c.t.tls, c.c)
+	n := C.Xts_tree_cursor_current_node(c.tls, c.c)
 	return c.t.cachedNode(n)
 }
 
@@ -496,7 +970,14 @@ func (c *TreeCursor) CurrentNode() *Node {
 //
 // This returns empty string if the current node doesn't have a field.
 func (c *TreeCursor) CurrentFieldName() string {
-	return libc.GoString(C.Xts_tree_cursor_current_field_name(c.t.tls, c.c))
+	return libc.GoString(C.Xts_tree_cursor_current_field_name(c.tls, c.c))
+}
+
+// CurrentFieldID gets the field ID of the tree cursor's current node.
+//
+// This returns zero if the current node doesn't have a field.
+func (c *TreeCursor) CurrentFieldID() FieldID {
+	return C.Xts_tree_cursor_current_field_id(c.tls, c.c)
 }
 
 // GoToParent moves the cursor to the parent of its current node.
@@ -504,7 +985,11 @@ func (c *TreeCursor) CurrentFieldName() string {
 // This returns `true` if the cursor successfully moved, and returns `false`
 // if there was no parent node (the cursor was already on the root node).
 func (c *TreeCursor) GoToParent() bool {
-	return C.Xts_tree_cursor_goto_parent(c.t.tls, c.c) != 0
+	if C.Xts_tree_cursor_goto_parent(c.tls, c.c) == 0 {
+		return false
+	}
+	c.depth--
+	return true
 }
 
 // GoToNextSibling moves the cursor to the next sibling of its current node.
@@ -512,7 +997,7 @@ func (c *TreeCursor) GoToParent() bool {
 // This returns `true` if the cursor successfully moved, and returns `false`
 // if there was no next sibling node.
 func (c *TreeCursor) GoToNextSibling() bool {
-	return C.Xts_tree_cursor_goto_next_sibling(c.t.tls, c.c) != 0
+	return C.Xts_tree_cursor_goto_next_sibling(c.tls, c.c) != 0
 }
 
 // GoToFirstChild moves the cursor to the first child of its current node.
@@ -520,7 +1005,11 @@ func (c *TreeCursor) GoToNextSibling() bool {
 // This returns `true` if the cursor successfully moved, and returns `false`
 // if there were no children.
 func (c *TreeCursor) GoToFirstChild() bool {
-	return C.Xts_tree_cursor_goto_first_child(c.t.tls, c.c) != 0
+	if C.Xts_tree_cursor_goto_first_child(c.tls, c.c) == 0 {
+		return false
+	}
+	c.depth++
+	return true
 }
 
 // GoToFirstChildForByte moves the cursor to the first child of its current node
@@ -529,7 +1018,65 @@ func (c *TreeCursor) GoToFirstChild() bool {
 // This returns the index of the child node if one was found, and returns -1
 // if no such child was found.
 func (c *TreeCursor) GoToFirstChildForByte(b uint32) int64 {
-	return C.Xts_tree_cursor_goto_first_child_for_byte(c.t.tls, c.c, uint32(b))
+	idx := C.Xts_tree_cursor_goto_first_child_for_byte(c.tls, c.c, uint32(b))
+	if idx >= 0 {
+		c.depth++
+	}
+	return idx
+}
+
+// GoToFirstChildForPoint moves the cursor to the first child of its current node
+// that extends beyond the given position.
+//
+// This returns the index of the child node if one was found, and returns -1
+// if no such child was found.
+func (c *TreeCursor) GoToFirstChildForPoint(p Point) int64 {
+	idx := C.Xts_tree_cursor_goto_first_child_for_point(c.tls, c.c, C.TSPoint{
+		Row:    uint32(p.Row),
+		Column: uint32(p.Column),
+	})
+	if idx >= 0 {
+		c.depth++
+	}
+	return idx
+}
+
+// WalkAction tells TreeCursor.Walk how to continue after visiting a node.
+type WalkAction int
+
+const (
+	// WalkContinue continues the walk with the node's children.
+	WalkContinue WalkAction = iota
+	// WalkSkipChildren continues the walk without visiting the node's children.
+	WalkSkipChildren
+	// WalkStop stops the walk.
+	WalkStop
+)
+
+// Walk visits the cursor's current node and all its descendants in depth-first order,
+// calling f with the cursor on each node. f must not move the cursor.
+//
+// If f returns WalkStop, the cursor is left on the node f was called with.
+// Otherwise the cursor is back on the node the walk started at when Walk returns.
+func (c *TreeCursor) Walk(f func(c *TreeCursor) WalkAction) {
+	depth := 0
+	for {
+		action := f(c)
+		if action == WalkStop {
+			return
+		}
+		if action != WalkSkipChildren && c.GoToFirstChild() {
+			depth++
+			continue
+		}
+		for depth > 0 && !c.GoToNextSibling() {
+			c.GoToParent()
+			depth--
+		}
+		if depth == 0 {
+			return
+		}
+	}
 }
 
 // QueryErrorType - value that indicates the type of QueryError.
@@ -541,6 +1088,10 @@ const (
 	QueryErrorNodeType
 	QueryErrorField
 	QueryErrorCapture
+	QueryErrorStructure
+	// QueryErrorPredicate indicates an invalid use of a built-in predicate, like #eq? or #match?.
+	// The offset is the start of the pattern containing it.
+	QueryErrorPredicate
 )
 
 // QueryError - if there is an error in the query,
@@ -568,6 +1119,12 @@ func (qe *QueryError) Error() string {
 	case QueryErrorCapture:
 		return fmt.Sprintf("capture error (offset: %d)", qe.Offset)
 
+	case QueryErrorStructure:
+		return fmt.Sprintf("structure error (offset: %d)", qe.Offset)
+
+	case QueryErrorPredicate:
+		return fmt.Sprintf("predicate error (offset: %d)", qe.Offset)
+
 	default:
 		return fmt.Sprintf("unknown error (offset: %d)", qe.Offset)
 	}
@@ -575,15 +1132,20 @@ func (qe *QueryError) Error() string {
 
 // Query API
 type Query struct {
-	tls      *libc.TLS
 	c        uintptr
 	isClosed bool
+
+	// predicates holds the parsed predicates of each pattern
+	predicates [][]QueryPredicate
+	// captureNames holds the name of each capture by ID
+	captureNames []string
 }
 
 // NewQuery creates a query by specifying a string containing one or more patterns.
 // In case of error returns QueryError.
 func NewQuery(pattern []byte, l *Language) (*Query, error) {
-	tls := libc.NewTLS()
+	tls := getTLS()
+	defer putTLS(tls)
 	input := cbytes(tls, pattern)
 	defer libc.Xfree(tls, input)
 	erroff := libc.Xmalloc(tls, types.Size_t(unsafe.Sizeof(uint32(0))))
@@ -600,13 +1162,20 @@ func NewQuery(pattern []byte, l *Language) (*Query, error) {
 		errtype,
 	)
 	if errtype := *(*C.TSQueryError)(unsafe.Pointer(errtype)); errtype != C.TSQueryError(QueryErrorNone) {
-		tls.Close()
 		erroff := *(*uint32)(unsafe.Pointer(erroff))
 		return nil, &QueryError{Offset: uint32(erroff), Type: QueryErrorType(errtype)}
 	}
 
-	q := &Query{tls: tls, c: c}
+	q := &Query{c: c}
 	runtime.SetFinalizer(q, (*Query).Close)
+	q.captureNames = make([]string, q.CaptureCount())
+	for i := range q.captureNames {
+		q.captureNames[i] = q.CaptureNameForId(uint32(i))
+	}
+	if err := q.parsePredicates(); err != nil {
+		q.Close()
+		return nil, err
+	}
 
 	return q, nil
 }
@@ -614,23 +1183,30 @@ func NewQuery(pattern []byte, l *Language) (*Query, error) {
 // Close should be called to ensure that all the memory used by the query is freed.
 func (q *Query) Close() {
 	if !q.isClosed {
-		C.Xts_query_delete(q.tls, q.c)
-		q.tls.Close()
+		tls := getTLS()
+		C.Xts_query_delete(tls, q.c)
+		putTLS(tls)
 	}
 
 	q.isClosed = true
 }
 
 func (q *Query) PatternCount() uint32 {
-	return uint32(C.Xts_query_pattern_count(q.tls, q.c))
+	tls := getTLS()
+	defer putTLS(tls)
+	return uint32(C.Xts_query_pattern_count(tls, q.c))
 }
 
 func (q *Query) CaptureCount() uint32 {
-	return uint32(C.Xts_query_capture_count(q.tls, q.c))
+	tls := getTLS()
+	defer putTLS(tls)
+	return uint32(C.Xts_query_capture_count(tls, q.c))
 }
 
 func (q *Query) StringCount() uint32 {
-	return uint32(C.Xts_query_string_count(q.tls, q.c))
+	tls := getTLS()
+	defer putTLS(tls)
+	return uint32(C.Xts_query_string_count(tls, q.c))
 }
 
 type QueryPredicateStepType int
@@ -647,9 +1223,11 @@ type QueryPredicateStep struct {
 }
 
 func (q *Query) PredicatesForPattern(patternIndex uint32) []QueryPredicateStep {
-	lengthPtr := libc.Xmalloc(q.tls, types.Size_t(unsafe.Sizeof(uint32(0))))
-	defer libc.Xfree(q.tls, lengthPtr)
-	cPredicateStep := C.Xts_query_predicates_for_pattern(q.tls, q.c, uint32(patternIndex), lengthPtr)
+	tls := getTLS()
+	defer putTLS(tls)
+	lengthPtr := libc.Xmalloc(tls, types.Size_t(unsafe.Sizeof(uint32(0))))
+	defer libc.Xfree(tls, lengthPtr)
+	cPredicateStep := C.Xts_query_predicates_for_pattern(tls, q.c, uint32(patternIndex), lengthPtr)
 	count := int(*(*uint32)(unsafe.Pointer(lengthPtr)))
 	predicateSteps := make([]QueryPredicateStep, 0, count)
 
@@ -665,35 +1243,83 @@ func (q *Query) PredicatesForPattern(patternIndex uint32) []QueryPredicateStep {
 }
 
 func (q *Query) CaptureNameForId(id uint32) string {
-	lengthPtr := libc.Xmalloc(q.tls, types.Size_t(unsafe.Sizeof(uint32(0))))
-	defer libc.Xfree(q.tls, lengthPtr)
-	name := C.Xts_query_capture_name_for_id(q.tls, q.c, uint32(id), lengthPtr)
+	tls := getTLS()
+	defer putTLS(tls)
+	lengthPtr := libc.Xmalloc(tls, types.Size_t(unsafe.Sizeof(uint32(0))))
+	defer libc.Xfree(tls, lengthPtr)
+	name := C.Xts_query_capture_name_for_id(tls, q.c, uint32(id), lengthPtr)
 	return goStringN(name, int(*(*uint32)(unsafe.Pointer(lengthPtr))))
 }
 
 func (q *Query) StringValueForId(id uint32) string {
-	lengthPtr := libc.Xmalloc(q.tls, types.Size_t(unsafe.Sizeof(uint32(0))))
-	defer libc.Xfree(q.tls, lengthPtr)
-	value := C.Xts_query_string_value_for_id(q.tls, q.c, uint32(id), lengthPtr)
+	tls := getTLS()
+	defer putTLS(tls)
+	lengthPtr := libc.Xmalloc(tls, types.Size_t(unsafe.Sizeof(uint32(0))))
+	defer libc.Xfree(tls, lengthPtr)
+	value := C.Xts_query_string_value_for_id(tls, q.c, uint32(id), lengthPtr)
 	return goStringN(value, int(*(*uint32)(unsafe.Pointer(lengthPtr))))
 }
 
+// StartByteForPattern returns the byte offset where the pattern with the given index starts
+// in the query's source.
+func (q *Query) StartByteForPattern(patternIndex uint32) uint32 {
+	tls := getTLS()
+	defer putTLS(tls)
+	return uint32(C.Xts_query_start_byte_for_pattern(tls, q.c, patternIndex))
+}
+
+// StepIsDefinite reports whether the step of a pattern at the given byte offset
+// in the query's source is guaranteed to match once the preceding steps have matched.
+func (q *Query) StepIsDefinite(byteOffset uint32) bool {
+	tls := getTLS()
+	defer putTLS(tls)
+	return C.Xts_query_step_is_definite(tls, q.c, byteOffset) != 0
+}
+
+// DisableCapture removes the capture with the given name from the query's patterns.
+// The patterns still match, but the nodes aren't captured.
+// This can't be undone.
+func (q *Query) DisableCapture(name string) {
+	tls := getTLS()
+	defer putTLS(tls)
+	cname := cbytes(tls, []byte(name))
+	defer libc.Xfree(tls, cname)
+	C.Xts_query_disable_capture(tls, q.c, cname, uint32(len(name)))
+}
+
+// DisablePattern removes the pattern with the given index from the query.
+// This can't be undone.
+func (q *Query) DisablePattern(patternIndex uint32) {
+	tls := getTLS()
+	defer putTLS(tls)
+	C.Xts_query_disable_pattern(tls, q.c, patternIndex)
+}
+
 // QueryCursor carries the state needed for processing the queries.
 type QueryCursor struct {
 	tls *libc.TLS
 	c   uintptr
 	t   *Tree
+	q   *Query
+
+	// scratch holds a TSQueryMatch followed by a capture index,
+	// reused by every call that gets the next match or capture.
+	scratch uintptr
 
 	isClosed bool
 }
 
+// scratchCaptureIndexOffset is the offset of the capture index in QueryCursor.scratch.
+const scratchCaptureIndexOffset = (unsafe.Sizeof(C.TSQueryMatch{}) + 3) &^ 3
+
 // NewQueryCursor creates a query cursor.
 func NewQueryCursor() *QueryCursor {
 	tls := libc.NewTLS()
 	qc := &QueryCursor{
-		tls: tls,
-		c:   C.Xts_query_cursor_new(tls),
-		t:   nil,
+		tls:     tls,
+		c:       C.Xts_query_cursor_new(tls),
+		t:       nil,
+		scratch: libc.Xmalloc(tls, types.Size_t(scratchCaptureIndexOffset+unsafe.Sizeof(uint32(0)))),
 	}
 	runtime.SetFinalizer(qc, (*QueryCursor).Close)
 
@@ -703,6 +1329,7 @@ func NewQueryCursor() *QueryCursor {
 // Exec executes the query on a given syntax node.
 func (qc *QueryCursor) Exec(q *Query, n *Node) {
 	qc.t = n.t
+	qc.q = q
 	C.Xts_query_cursor_exec(qc.tls, qc.c, q.c, n.c)
 }
 
@@ -718,11 +1345,42 @@ func (qc *QueryCursor) SetPointRange(startPoint Point, endPoint Point) {
 	C.Xts_query_cursor_set_point_range(qc.tls, qc.c, cStartPoint, cEndPoint)
 }
 
+// SetByteRange limits the cursor to matches that intersect the given byte range.
+func (qc *QueryCursor) SetByteRange(startByte uint32, endByte uint32) {
+	C.Xts_query_cursor_set_byte_range(qc.tls, qc.c, startByte, endByte)
+}
+
+// SetMatchLimit sets the maximum number of in-progress matches the cursor keeps.
+// When the limit is reached, the earliest in-progress matches are dropped
+// and DidExceedMatchLimit reports true.
+// This bounds the memory used by queries that match many nodes at once.
+func (qc *QueryCursor) SetMatchLimit(limit uint32) {
+	C.Xts_query_cursor_set_match_limit(qc.tls, qc.c, limit)
+}
+
+// MatchLimit returns the maximum number of in-progress matches set with SetMatchLimit.
+func (qc *QueryCursor) MatchLimit() uint32 {
+	return uint32(C.Xts_query_cursor_match_limit(qc.tls, qc.c))
+}
+
+// DidExceedMatchLimit reports whether in-progress matches have been dropped
+// since the last call to Exec because of the match limit.
+func (qc *QueryCursor) DidExceedMatchLimit() bool {
+	return C.Xts_query_cursor_did_exceed_match_limit(qc.tls, qc.c) != 0
+}
+
+// RemoveMatch removes the match with the given ID from the cursor,
+// so that NextCapture doesn't return the rest of its captures.
+func (qc *QueryCursor) RemoveMatch(id uint32) {
+	C.Xts_query_cursor_remove_match(qc.tls, qc.c, id)
+}
+
 // Close should be called to ensure that all the memory used by the query
 // cursor is freed.
 func (qc *QueryCursor) Close() {
 	if !qc.isClosed {
 		C.Xts_query_cursor_delete(qc.tls, qc.c)
+		libc.Xfree(qc.tls, qc.scratch)
 		qc.tls.Close()
 	}
 
@@ -733,6 +1391,8 @@ func (qc *QueryCursor) Close() {
 type QueryCapture struct {
 	Index uint32
 	Node  *Node
+	// Name is the name of the capture, without the leading '@'.
+	Name string
 }
 
 // QueryMatch - you can then iterate over the matches.
@@ -740,23 +1400,45 @@ type QueryMatch struct {
 	ID           uint32
 	PatternIndex uint16
 	Captures     []QueryCapture
+
+	// Properties holds the properties set with #set! in the matched pattern.
+	// PropertyPredicates holds the #is? and #is-not? predicates in the matched pattern,
+	// which are left for the caller to check.
+	// Both are only filled in by methods that evaluate predicates, like NextFilteredMatch.
+	Properties         []QueryProperty
+	PropertyPredicates []QueryPropertyPredicate
 }
 
 func (qc *QueryCursor) queryMatchFromC(cqmPtr uintptr) *QueryMatch {
+	cqm := (*C.TSQueryMatch)(unsafe.Pointer(cqmPtr))
+	qm := &QueryMatch{Captures: make([]QueryCapture, 0, int(cqm.Capture_count))}
+	qc.fillQueryMatch(qm, cqmPtr)
+	return qm
+}
+
+// fillQueryMatch sets qm to the match at cqmPtr, reusing its slices.
+func (qc *QueryCursor) fillQueryMatch(qm *QueryMatch, cqmPtr uintptr) {
 	cqm := (*C.TSQueryMatch)(unsafe.Pointer(cqmPtr))
 	count := int(cqm.Capture_count)
-	qm := &QueryMatch{
-		ID:           uint32(cqm.Id),
-		PatternIndex: uint16(cqm.Pattern_index),
-		Captures:     make([]QueryCapture, 0, count),
-	}
+	qm.ID = uint32(cqm.Id)
+	qm.PatternIndex = uint16(cqm.Pattern_index)
+	qm.Captures = qm.Captures[:0]
 	for i := 0; i < count; i++ {
 		c := (*C.TSQueryCapture)(unsafe.Pointer(cqm.Captures + uintptr(i)*unsafe.Sizeof(C.TSQueryCapture{})))
 		idx := uint32(c.Index)
 		node := qc.t.cachedNode(c.Node)
-		qm.Captures = append(qm.Captures, QueryCapture{idx, node})
+		qm.Captures = append(qm.Captures, QueryCapture{Index: idx, Node: node, Name: qc.q.captureNames[idx]})
 	}
-	return qm
+}
+
+// Capture returns the first node captured with the given name, or nil if there is none.
+func (qm *QueryMatch) Capture(name string) *Node {
+	for _, c := range qm.Captures {
+		if c.Name == name {
+			return c.Node
+		}
+	}
+	return nil
 }
 
 // NextMatch iterates over matches.
@@ -764,23 +1446,18 @@ func (qc *QueryCursor) queryMatchFromC(cqmPtr uintptr) *QueryMatch {
 // Otherwise, it will populate the QueryMatch with data
 // about which pattern matched and which nodes were captured.
 func (qc *QueryCursor) NextMatch() (*QueryMatch, bool) {
-	cqmPtr := libc.Xmalloc(qc.tls, types.Size_t(unsafe.Sizeof(C.TSQueryMatch{})))
-	defer libc.Xfree(qc.tls, cqmPtr)
-	if C.Xts_query_cursor_next_match(qc.tls, qc.c, cqmPtr) == 0 {
+	if C.Xts_query_cursor_next_match(qc.tls, qc.c, qc.scratch) == 0 {
 		return nil, false
 	}
-	return qc.queryMatchFromC(cqmPtr), true
+	return qc.queryMatchFromC(qc.scratch), true
 }
 
 func (qc *QueryCursor) NextCapture() (*QueryMatch, uint32, bool) {
-	cqmPtr := libc.Xmalloc(qc.tls, types.Size_t(unsafe.Sizeof(C.TSQueryMatch{})))
-	defer libc.Xfree(qc.tls, cqmPtr)
-	captureIndexPtr := libc.Xmalloc(qc.tls, types.Size_t(unsafe.Sizeof(uint32(0))))
-	defer libc.Xfree(qc.tls, cqmPtr)
-	if C.Xts_query_cursor_next_capture(qc.tls, qc.c, cqmPtr, captureIndexPtr) == 0 {
+	captureIndexPtr := qc.scratch + scratchCaptureIndexOffset
+	if C.Xts_query_cursor_next_capture(qc.tls, qc.c, qc.scratch, captureIndexPtr) == 0 {
 		return nil, 0, false
 	}
-	qm := qc.queryMatchFromC(cqmPtr)
+	qm := qc.queryMatchFromC(qc.scratch)
 	return qm, *(*uint32)(unsafe.Pointer(captureIndexPtr)), true
 }
 
@@ -788,33 +1465,126 @@ func (qc *QueryCursor) NextCapture() (*QueryMatch, uint32, bool) {
 type readFuncsMap struct {
 	sync.Mutex
 
-	funcs map[int]ReadFunc
+	funcs map[int]*readFunc
 	count int
 }
 
+// readFunc is a registered ReadFunc along with the C copy of the last chunk
+// it returned. tree-sitter only requires a chunk to stay valid until the next
+// call to read, so the buffer is reused for each call.
+type readFunc struct {
+	read ReadFunc
+	buf  uintptr
+}
+
 func (m *readFuncsMap) register(f ReadFunc) int {
 	m.Lock()
 	defer m.Unlock()
 
+	m.count++
+	m.funcs[m.count] = &readFunc{read: f}
+	return m.count
+}
+
+func (m *readFuncsMap) unregister(tls *libc.TLS, id int) {
+	m.Lock()
+	defer m.Unlock()
+
+	if f := m.funcs[id]; f != nil {
+		libc.Xfree(tls, f.buf)
+	}
+	delete(m.funcs, id)
+}
+
+func (m *readFuncsMap) get(id int) *readFunc {
+	m.Lock()
+	defer m.Unlock()
+
+	return m.funcs[id]
+}
+
+// readFuncPtr is callReadFunc as a C function pointer
+// that can be used as the read field of a TSInput.
+var readFuncPtr = *(*uintptr)(unsafe.Pointer(&struct {
+	f func(*libc.TLS, uintptr, uint32, C.TSPoint, uintptr) uintptr
+}{callReadFunc}))
+
+// callReadFunc is called from C to read a chunk of text.
+// The payload is the ID of a ReadFunc registered in readFuncs.
+func callReadFunc(tls *libc.TLS, payload uintptr, offset uint32, position C.TSPoint, bytesRead uintptr) uintptr {
+	f := readFuncs.get(int(payload))
+	libc.Xfree(tls, f.buf)
+	f.buf = 0
+
+	chunk := f.read(offset, Point{Row: position.Row, Column: position.Column})
+	*(*uint32)(unsafe.Pointer(bytesRead)) = uint32(len(chunk))
+	if len(chunk) == 0 {
+		return 0
+	}
+	f.buf = cbytes(tls, chunk)
+	return f.buf
+}
+
+// keeps loggers for parsers
+type logFuncsMap struct {
+	sync.Mutex
+
+	funcs map[int]func(LogType, string)
+	count int
+}
+
+func (m *logFuncsMap) register(f func(LogType, string)) int {
+	m.Lock()
+	defer m.Unlock()
+
 	m.count++
 	m.funcs[m.count] = f
 	return m.count
 }
 
-func (m *readFuncsMap) unregister(id int) {
+func (m *logFuncsMap) unregister(id int) {
 	m.Lock()
 	defer m.Unlock()
 
 	delete(m.funcs, id)
 }
 
-func (m *readFuncsMap) get(id int) ReadFunc {
+func (m *logFuncsMap) get(id int) func(LogType, string) {
 	m.Lock()
 	defer m.Unlock()
 
 	return m.funcs[id]
 }
 
+// logFuncPtr is callLogFunc as a C function pointer
+// that can be used as the log field of a TSLogger.
+var logFuncPtr = *(*uintptr)(unsafe.Pointer(&struct {
+	f func(*libc.TLS, uintptr, C.TSLogType, uintptr)
+}{callLogFunc}))
+
+// callLogFunc is called from C to log a message.
+// The payload is the ID of a logger registered in logFuncs.
+func callLogFunc(tls *libc.TLS, payload uintptr, logType C.TSLogType, msg uintptr) {
+	if f := logFuncs.get(int(payload)); f != nil {
+		f(LogType(logType), libc.GoString(msg))
+	}
+}
+
+func getTLS() *libc.TLS {
+	return tlspool.Get()
+}
+
+func putTLS(tls *libc.TLS) {
+	tlspool.Put(tls)
+}
+
+func pointToC(p Point) C.TSPoint {
+	return C.TSPoint{
+		Row:    uint32(p.Row),
+		Column: uint32(p.Column),
+	}
+}
+
 func cbytes(tls *libc.TLS, b []byte) uintptr {
 	cb := libc.Xmalloc(tls, types.Size_t(len(b)))
 	for i, bb := range b {
@@ -0,0 +1,1004 @@
+package sitter_test
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf16"
+
+	sitter "github.com/yourbase/treesitter"
+	"github.com/yourbase/treesitter/json"
+	"github.com/yourbase/treesitter/python"
+)
+
+func TestParseInput(t *testing.T) {
+	const sourceCode = "{\"a\": [1, null],\n \"b\": true}"
+
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(json.GetLanguage())
+
+	want := parser.Parse(nil, []byte(sourceCode))
+	defer want.Close()
+
+	for _, chunkSize := range []int{1, 3, len(sourceCode)} {
+		var reads int
+		tree := parser.ParseInput(nil, sitter.Input{
+			Read: func(offset uint32, position sitter.Point) []byte {
+				reads++
+				if int(offset) >= len(sourceCode) {
+					return nil
+				}
+				end := int(offset) + chunkSize
+				if end > len(sourceCode) {
+					end = len(sourceCode)
+				}
+				return []byte(sourceCode[offset:end])
+			},
+			Encoding: sitter.InputEncodingUTF8,
+		})
+		if got, want := tree.RootNode().String(), want.RootNode().String(); got != want {
+			t.Errorf("chunk size %d: tree = %s; want %s", chunkSize, got, want)
+		}
+		if reads < len(sourceCode)/chunkSize {
+			t.Errorf("chunk size %d: read called %d times; want at least %d", chunkSize, reads, len(sourceCode)/chunkSize)
+		}
+		tree.Close()
+	}
+}
+
+func TestParseEncodingUTF16(t *testing.T) {
+	const sourceCode = "{\"\u00e9\u4e16\": 1,\n \"\U0001f600\": 2}"
+	units := utf16.Encode([]rune(sourceCode))
+	content := make([]byte, 2*len(units))
+	for i, u := range units {
+		binary.LittleEndian.PutUint16(content[2*i:], u)
+	}
+
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(json.GetLanguage())
+	tree := parser.ParseEncoding(nil, content, sitter.InputEncodingUTF16)
+	defer tree.Close()
+
+	if got := tree.Encoding(); got != sitter.InputEncodingUTF16 {
+		t.Errorf("tree.Encoding() = %v; want %v", got, sitter.InputEncodingUTF16)
+	}
+	root := tree.RootNode()
+	if want := "(document (object (pair key: (string (string_content)) value: (number)) (pair key: (string (string_content)) value: (number))))"; root.String() != want {
+		t.Fatalf("tree = %s; want %s", root, want)
+	}
+
+	second := root.NamedChild(0).NamedChild(1)
+	key := second.ChildByFieldName("key")
+	if got, want := string(utf16.Decode(key.ContentUTF16(units))), "\"\U0001f600\""; got != want {
+		t.Errorf("key.ContentUTF16(...) = %q; want %q", got, want)
+	}
+	if got, want := key.Content(content), string(content[2*key.StartOffset():2*key.EndOffset()]); got != want {
+		t.Errorf("key.Content(...) = %q; want %q", got, want)
+	}
+	if got, want := key.StartPosition(), (sitter.Point{Row: 1, Column: 1}); got != want {
+		t.Errorf("key.StartPosition() = %v; want %v", got, want)
+	}
+	if got, want := key.EndPosition(), (sitter.Point{Row: 1, Column: 5}); got != want {
+		t.Errorf("key.EndPosition() = %v; want %v", got, want)
+	}
+	if got, want := key.EndPoint(), (sitter.Point{Row: 1, Column: 10}); got != want {
+		t.Errorf("key.EndPoint() = %v; want %v", got, want)
+	}
+	value := second.ChildByFieldName("value")
+	if got, want := value.StartOffset(), uint32(len(units)-2); got != want {
+		t.Errorf("value.StartOffset() = %d; want %d", got, want)
+	}
+}
+
+func TestParseContext(t *testing.T) {
+	sourceCode := []byte("[" + strings.Repeat("{\"a\": [1, 2, null]}, ", 1000) + "true]")
+
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(json.GetLanguage())
+	var logs int
+	parser.SetLogger(func(sitter.LogType, string) { logs++ })
+	want := parser.Parse(nil, sourceCode)
+	defer want.Close()
+	total := logs
+
+	// Cancel halfway through the parse.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	logs = 0
+	parser.SetLogger(func(sitter.LogType, string) {
+		logs++
+		if logs == total/2 && ctx.Err() == nil {
+			cancel()
+			// Give ParseContext time to notice.
+			time.Sleep(10 * time.Millisecond)
+		}
+	})
+	tree, err := parser.ParseContext(ctx, nil, sourceCode)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("ParseContext(canceled, ...) = %v, %v; want _, %v", tree, err, context.Canceled)
+	}
+	if logs >= total {
+		t.Errorf("canceled parse logged %d messages; want fewer than the %d of a full parse", logs, total)
+	}
+
+	// Parsing again resumes the halted parse.
+	logs = 0
+	tree, err = parser.ParseContext(context.Background(), nil, sourceCode)
+	if err != nil {
+		t.Fatal("ParseContext(context.Background(), ...):", err)
+	}
+	defer tree.Close()
+	if logs >= total {
+		t.Errorf("resumed parse logged %d messages; want fewer than the %d of a full parse", logs, total)
+	}
+	if got, want := tree.RootNode().String(), want.RootNode().String(); got != want {
+		t.Errorf("tree = %s; want %s", got, want)
+	}
+}
+
+func TestParserSetLogger(t *testing.T) {
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(json.GetLanguage())
+
+	counts := make(map[sitter.LogType]int)
+	parser.SetLogger(func(logType sitter.LogType, msg string) {
+		if msg == "" {
+			t.Errorf("empty %v message", logType)
+		}
+		counts[logType]++
+	})
+	parser.Parse(nil, []byte("[1, null]")).Close()
+	if counts[sitter.LogTypeParse] == 0 || counts[sitter.LogTypeLex] == 0 {
+		t.Errorf("logged %v; want parse and lex messages", counts)
+	}
+
+	parser.SetLogger(nil)
+	before := counts[sitter.LogTypeParse] + counts[sitter.LogTypeLex]
+	parser.Parse(nil, []byte("[1, null]")).Close()
+	if after := counts[sitter.LogTypeParse] + counts[sitter.LogTypeLex]; after != before {
+		t.Errorf("logged %d messages after SetLogger(nil)", after-before)
+	}
+}
+
+func TestLogTypeString(t *testing.T) {
+	tests := []struct {
+		t    sitter.LogType
+		want string
+	}{
+		{sitter.LogTypeParse, "Parse"},
+		{sitter.LogTypeLex, "Lex"},
+		{sitter.LogType(2), "LogType(2)"},
+		{sitter.LogType(-1), "LogType(-1)"},
+	}
+	for _, test := range tests {
+		if got := test.t.String(); got != test.want {
+			t.Errorf("LogType(%d).String() = %q; want %q", int(test.t), got, test.want)
+		}
+	}
+}
+
+func TestSymbolTypeString(t *testing.T) {
+	tests := []struct {
+		t    sitter.SymbolType
+		want string
+	}{
+		{sitter.SymbolTypeRegular, "Regular"},
+		{sitter.SymbolTypeAuxiliary, "Auxiliary"},
+		{sitter.SymbolType(3), "SymbolType(3)"},
+		{sitter.SymbolType(-1), "SymbolType(-1)"},
+	}
+	for _, test := range tests {
+		if got := test.t.String(); got != test.want {
+			t.Errorf("SymbolType(%d).String() = %q; want %q", int(test.t), got, test.want)
+		}
+	}
+}
+
+func TestDotGraphs(t *testing.T) {
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(json.GetLanguage())
+
+	stack := new(strings.Builder)
+	if err := parser.PrintDotGraphs(stack); err != nil {
+		t.Fatal("PrintDotGraphs:", err)
+	}
+	tree := parser.Parse(nil, []byte("[1, null]"))
+	defer tree.Close()
+	if err := parser.PrintDotGraphs(nil); err != nil {
+		t.Error("PrintDotGraphs(nil):", err)
+	}
+	if !strings.Contains(stack.String(), "digraph stack {") {
+		t.Errorf("parser DOT graphs = %q; want stack graphs", stack)
+	}
+
+	graph := new(strings.Builder)
+	if err := tree.WriteDot(graph); err != nil {
+		t.Error("WriteDot:", err)
+	}
+	if got := graph.String(); !strings.HasPrefix(got, "digraph tree {") || !strings.Contains(got, "label=\"array\"") {
+		t.Errorf("tree DOT graph = %q; want graph with array node", got)
+	}
+}
+
+func TestChangedRanges(t *testing.T) {
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(json.GetLanguage())
+
+	oldTree := parser.Parse(nil, []byte("[1, null, 3]"))
+	defer oldTree.Close()
+	oldTree.Edit(sitter.EditInput{
+		StartIndex:  4,
+		OldEndIndex: 8,
+		NewEndIndex: 8,
+		StartPoint:  sitter.Point{Row: 0, Column: 4},
+		OldEndPoint: sitter.Point{Row: 0, Column: 8},
+		NewEndPoint: sitter.Point{Row: 0, Column: 8},
+	})
+	newTree := parser.Parse(oldTree, []byte("[1, true, 3]"))
+	defer newTree.Close()
+
+	got := oldTree.ChangedRanges(newTree)
+	want := []sitter.Range{{
+		StartPoint: sitter.Point{Row: 0, Column: 4},
+		EndPoint:   sitter.Point{Row: 0, Column: 8},
+		StartByte:  4,
+		EndByte:    8,
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ChangedRanges(...) = %+v; want %+v", got, want)
+	}
+	if got := newTree.ChangedRanges(newTree); len(got) != 0 {
+		t.Errorf("ChangedRanges(same tree) = %+v; want []", got)
+	}
+}
+
+func TestApplyTextEdit(t *testing.T) {
+	tests := []struct {
+		name      string
+		lang      *sitter.Language
+		src       string
+		fragments []string
+	}{
+		{
+			name:      "JSON",
+			lang:      json.GetLanguage(),
+			src:       "{\"a\": [1, null],\n \"b\": {\"c\": true}}\n",
+			fragments: []string{"", "1", "\"x\"", ", ", "[", "]", "{", "}", ":", "\n", "null", "\u00e9"},
+		},
+		{
+			name:      "Python",
+			lang:      python.GetLanguage(),
+			src:       "def f(x):\n    if x:\n        return [x, 1]\n    return None\n\nprint(f(2))\n",
+			fragments: []string{"", "x", " ", "    ", "\n", "(", ")", ":", "if y:\n", "return", "'s'", "#"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			parser := sitter.NewParser()
+			defer parser.Close()
+			parser.SetLanguage(test.lang)
+
+			rng := rand.New(rand.NewSource(1))
+			src := []byte(test.src)
+			tree := parser.Parse(nil, src)
+			for i := 0; i < 200; i++ {
+				start := uint32(rng.Intn(len(src) + 1))
+				oldEnd := start + uint32(rng.Intn(len(src)-int(start)+1)%4)
+				replacement := []byte(test.fragments[rng.Intn(len(test.fragments))])
+
+				newSrc := tree.ApplyTextEdit(src, start, oldEnd, replacement)
+				if want := string(src[:start]) + string(replacement) + string(src[oldEnd:]); string(newSrc) != want {
+					t.Fatalf("ApplyTextEdit(%q, %d, %d, %q) = %q; want %q", src, start, oldEnd, replacement, newSrc, want)
+				}
+				fresh := parser.Parse(nil, newSrc)
+				if got, want := tree.RootNode().EndPoint(), fresh.RootNode().EndPoint(); got != want {
+					t.Fatalf("after edit %d of %q, edited tree ends at %v; want %v", i, newSrc, got, want)
+				}
+				newTree := parser.Parse(tree, newSrc)
+				tree.Close()
+				tree, src = newTree, newSrc
+
+				if got, want := dumpTree(tree.RootNode()), dumpTree(fresh.RootNode()); got != want {
+					t.Fatalf("after edit %d, incremental parse of %q =\n%s\nwant:\n%s", i, src, got, want)
+				}
+				fresh.Close()
+			}
+			tree.Close()
+		})
+	}
+}
+
+// dumpTree formats the types and positions of n and all its descendants.
+func dumpTree(n *sitter.Node) string {
+	sb := new(strings.Builder)
+	var dump func(n *sitter.Node, depth int)
+	dump = func(n *sitter.Node, depth int) {
+		fmt.Fprintf(sb, "%s%s [%d-%d] %v-%v\n", strings.Repeat("  ", depth), n.Type(), n.StartByte(), n.EndByte(), n.StartPoint(), n.EndPoint())
+		for i := 0; i < int(n.ChildCount()); i++ {
+			dump(n.Child(i), depth+1)
+		}
+	}
+	dump(n, 0)
+	return sb.String()
+}
+
+func TestApplyTextEditPanics(t *testing.T) {
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(json.GetLanguage())
+	src := []byte("[1, 2]")
+	tree := parser.Parse(nil, src)
+	defer tree.Close()
+
+	for _, r := range [][2]uint32{{3, 2}, {2, 7}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("ApplyTextEdit(%q, %d, %d, ...) did not panic", src, r[0], r[1])
+				}
+			}()
+			tree.ApplyTextEdit(src, r[0], r[1], nil)
+		}()
+	}
+}
+
+func TestDropNodeCache(t *testing.T) {
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(json.GetLanguage())
+	tree := parser.Parse(nil, []byte("[1, null]"))
+	defer tree.Close()
+
+	array := tree.RootNode().NamedChild(0)
+	if tree.RootNode().NamedChild(0) != array {
+		t.Error("looking up the same node twice returned different *Node")
+	}
+	want := dumpTree(tree.RootNode())
+	tree.DropNodeCache()
+	if got := tree.RootNode().NamedChild(0); !got.Equal(array) {
+		t.Errorf("after DropNodeCache, node = %v; want %v", got, array)
+	}
+	if got := dumpTree(tree.RootNode()); got != want {
+		t.Errorf("after DropNodeCache, tree =\n%s\nwant:\n%s", got, want)
+	}
+	if got := array.NamedChild(1).Type(); got != "null" {
+		t.Errorf("after DropNodeCache, array.NamedChild(1).Type() = %q; want \"null\"", got)
+	}
+}
+
+// benchmarkSource is a large JSON document for benchmarks.
+var benchmarkSource = []byte("[" + strings.Repeat("{\"a\": [1, 2, null], \"b\": \"c\"}, ", 1000) + "true]")
+
+func BenchmarkTreeWalk(b *testing.B) {
+	src := benchmarkSource
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(json.GetLanguage())
+	tree := parser.Parse(nil, src)
+	defer tree.Close()
+
+	var walk func(n *sitter.Node) int
+	walk = func(n *sitter.Node) int {
+		count := 1
+		for i := 0; i < int(n.ChildCount()); i++ {
+			count += walk(n.Child(i))
+		}
+		return count
+	}
+
+	var before, after runtime.MemStats
+	var nodes int
+	b.ResetTimer()
+	runtime.ReadMemStats(&before)
+	for i := 0; i < b.N; i++ {
+		tree.DropNodeCache()
+		nodes += walk(tree.RootNode())
+	}
+	runtime.ReadMemStats(&after)
+	b.ReportMetric(float64(after.Mallocs-before.Mallocs)/float64(nodes), "allocs/node")
+	b.ReportMetric(float64(after.TotalAlloc-before.TotalAlloc)/float64(nodes), "B/node")
+}
+
+func TestTreeCursorWalk(t *testing.T) {
+	const src = "def f(x):\n    return [x, 1]\n\nprint(f(2))\n"
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(python.GetLanguage())
+	tree := parser.Parse(nil, []byte(src))
+	defer tree.Close()
+
+	var want []string
+	var walk func(n *sitter.Node, depth int)
+	walk = func(n *sitter.Node, depth int) {
+		want = append(want, fmt.Sprintf("%d %s", depth, n.Type()))
+		if n.Type() == "block" {
+			return
+		}
+		for i := 0; i < int(n.ChildCount()); i++ {
+			walk(n.Child(i), depth+1)
+		}
+	}
+	walk(tree.RootNode(), 0)
+
+	cursor := sitter.NewTreeCursor(tree.RootNode())
+	defer cursor.Close()
+	var got []string
+	cursor.Walk(func(c *sitter.TreeCursor) sitter.WalkAction {
+		n := c.CurrentNode()
+		got = append(got, fmt.Sprintf("%d %s", c.Depth(), n.Type()))
+		if n.Type() == "block" {
+			return sitter.WalkSkipChildren
+		}
+		return sitter.WalkContinue
+	})
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Walk visited:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if n := cursor.CurrentNode(); !n.Equal(tree.RootNode()) || cursor.Depth() != 0 {
+		t.Errorf("after Walk, cursor on %v at depth %d; want root at depth 0", n, cursor.Depth())
+	}
+
+	var stoppedAt string
+	cursor.Walk(func(c *sitter.TreeCursor) sitter.WalkAction {
+		if c.CurrentNode().Type() == "identifier" {
+			stoppedAt = c.CurrentNode().Content([]byte(src))
+			return sitter.WalkStop
+		}
+		return sitter.WalkContinue
+	})
+	if got := cursor.CurrentNode().Content([]byte(src)); got != "f" || stoppedAt != "f" {
+		t.Errorf("after stopped Walk, cursor on %q; want \"f\"", got)
+	}
+	if got := cursor.CurrentFieldName(); got != "name" {
+		t.Errorf("CurrentFieldName() = %q; want \"name\"", got)
+	}
+	if got := python.GetLanguage().FieldName(int(cursor.CurrentFieldID())); got != "name" {
+		t.Errorf("field name for CurrentFieldID() = %q; want \"name\"", got)
+	}
+}
+
+func TestTreeCursorCopy(t *testing.T) {
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(json.GetLanguage())
+	tree := parser.Parse(nil, []byte("[1,\n null,\n true]"))
+	defer tree.Close()
+
+	cursor := sitter.NewTreeCursor(tree.RootNode())
+	defer cursor.Close()
+	cursor.GoToFirstChild()
+	cp := cursor.Copy()
+	defer cp.Close()
+	if got := cp.GoToFirstChildForPoint(sitter.Point{Row: 1, Column: 2}); got != 3 {
+		t.Errorf("GoToFirstChildForPoint(1:2) = %d; want 3", got)
+	}
+	if got := cp.CurrentNode().Type(); got != "null" {
+		t.Errorf("copy on %q; want \"null\"", got)
+	}
+	if got := cp.Depth(); got != 2 {
+		t.Errorf("copy Depth() = %d; want 2", got)
+	}
+	if got := cursor.CurrentNode().Type(); got != "array" {
+		t.Errorf("original cursor on %q after moving copy; want \"array\"", got)
+	}
+	if got := cursor.CurrentFieldID(); got != 0 {
+		t.Errorf("CurrentFieldID() = %d; want 0", got)
+	}
+	if cp.GoToFirstChildForPoint(sitter.Point{Row: 9}) != -1 || cp.Depth() != 2 {
+		t.Errorf("GoToFirstChildForPoint past end moved cursor")
+	}
+}
+
+func BenchmarkTreeCursorWalk(b *testing.B) {
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(json.GetLanguage())
+	tree := parser.Parse(nil, benchmarkSource)
+	defer tree.Close()
+	cursor := sitter.NewTreeCursor(tree.RootNode())
+	defer cursor.Close()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var n int
+		cursor.Walk(func(c *sitter.TreeCursor) sitter.WalkAction {
+			n++
+			return sitter.WalkContinue
+		})
+	}
+}
+
+func TestNodeLookups(t *testing.T) {
+	t.Run("JSON", func(t *testing.T) {
+		const src = "{\"a\": [1, null],\n \"b\": true}"
+		parser := sitter.NewParser()
+		defer parser.Close()
+		parser.SetLanguage(json.GetLanguage())
+		tree := parser.Parse(nil, []byte(src))
+		defer tree.Close()
+		root := tree.RootNode()
+
+		null := strings.Index(src, "null")
+		if n := root.DescendantForByteRange(uint32(null), uint32(null)); n.Type() != "null" {
+			t.Errorf("DescendantForByteRange(%d, %d) = %v; want null", null, null, n)
+		}
+		if n := root.DescendantForByteRange(uint32(null-2), uint32(null-1)); n.Type() != "," {
+			t.Errorf("DescendantForByteRange(%d, %d) = %v; want \",\"", null-2, null-1, n)
+		}
+		if n := root.NamedDescendantForByteRange(uint32(null-2), uint32(null-1)); n.Type() != "array" {
+			t.Errorf("NamedDescendantForByteRange(%d, %d) = %v; want array", null-2, null-1, n)
+		}
+		if n := root.DescendantForPointRange(sitter.Point{Row: 1, Column: 8}, sitter.Point{Row: 1, Column: 8}); n.Type() != "true" {
+			t.Errorf("DescendantForPointRange(1:8, 1:8) = %v; want true", n)
+		}
+		if n := root.NamedDescendantForPointRange(sitter.Point{Row: 1, Column: 2}, sitter.Point{Row: 1, Column: 3}); n.Type() != "string_content" {
+			t.Errorf("NamedDescendantForPointRange(1:2, 1:3) = %v; want string_content", n)
+		}
+
+		object := root.NamedChild(0)
+		if n := object.FirstChildForByte(2); n.Type() != "pair" {
+			t.Errorf("FirstChildForByte(2) = %v; want pair", n)
+		}
+		pair := object.NamedChild(0)
+		if n := pair.FirstNamedChildForByte(4); n.Type() != "array" {
+			t.Errorf("FirstNamedChildForByte(4) = %v; want array", n)
+		}
+		for i, want := range []string{"key", "", "value"} {
+			if got := pair.FieldNameForChild(i); got != want {
+				t.Errorf("FieldNameForChild(%d) = %q; want %q", i, got, want)
+			}
+		}
+		if n := object.FirstChildForByte(uint32(len(src))); n != nil {
+			t.Errorf("FirstChildForByte(end) = %v; want nil", n)
+		}
+	})
+
+	t.Run("Python", func(t *testing.T) {
+		const src = "def f(x):\n    # comment\n    return x\n"
+		parser := sitter.NewParser()
+		defer parser.Close()
+		parser.SetLanguage(python.GetLanguage())
+		tree := parser.Parse(nil, []byte(src))
+		defer tree.Close()
+		root := tree.RootNode()
+
+		// Go to definition of the identifier under the cursor.
+		ident := root.NamedDescendantForPointRange(sitter.Point{Row: 2, Column: 11}, sitter.Point{Row: 2, Column: 11})
+		if ident.Type() != "identifier" || ident.Content([]byte(src)) != "x" {
+			t.Fatalf("NamedDescendantForPointRange(2:11, 2:11) = %v; want identifier x", ident)
+		}
+		funcDef := root.NamedChild(0)
+		cursor := sitter.NewTreeCursor(funcDef)
+		defer cursor.Close()
+		cursor.GoToFirstChild()
+		var bodyID sitter.FieldID
+		for {
+			if cursor.CurrentFieldName() == "body" {
+				bodyID = cursor.CurrentFieldID()
+				break
+			}
+			if !cursor.GoToNextSibling() {
+				t.Fatal("function_definition has no body")
+			}
+		}
+		body := funcDef.ChildByFieldID(bodyID)
+		if body == nil || !body.Equal(funcDef.ChildByFieldName("body")) {
+			t.Errorf("ChildByFieldID(%d) = %v; want body", bodyID, body)
+		}
+		comment := root.DescendantForPointRange(sitter.Point{Row: 1, Column: 6}, sitter.Point{Row: 1, Column: 6})
+		if comment.Type() != "comment" || !comment.IsExtra() {
+			t.Errorf("DescendantForPointRange(1:6, 1:6) = %v (extra = %t); want extra comment", comment, comment.IsExtra())
+		}
+		if body.NamedChild(0).IsExtra() {
+			t.Errorf("return statement is extra")
+		}
+	})
+}
+
+func TestLanguageIntrospection(t *testing.T) {
+	lang := json.GetLanguage()
+	if got := lang.Version(); got != 13 {
+		t.Errorf("Version() = %d; want 13", got)
+	}
+
+	pair := lang.SymbolForName("pair", true)
+	if pair == 0 || lang.SymbolName(pair) != "pair" {
+		t.Errorf("SymbolForName(\"pair\", true) = %d (%q)", pair, lang.SymbolName(pair))
+	}
+	if got := lang.SymbolMetadata(pair); got != (sitter.SymbolMetadata{Visible: true, Named: true}) {
+		t.Errorf("SymbolMetadata(pair) = %+v", got)
+	}
+	colon := lang.SymbolForName(":", false)
+	if colon == 0 {
+		t.Fatal("SymbolForName(\":\", false) = 0")
+	}
+	if got := lang.SymbolMetadata(colon); got != (sitter.SymbolMetadata{Visible: true}) {
+		t.Errorf("SymbolMetadata(\":\") = %+v", got)
+	}
+	if got := lang.SymbolForName("pair", false); got != 0 {
+		t.Errorf("SymbolForName(\"pair\", false) = %d; want 0", got)
+	}
+	if got := lang.SymbolForName("nope", true); got != 0 {
+		t.Errorf("SymbolForName(\"nope\", true) = %d; want 0", got)
+	}
+	// Supertypes are hidden, but queries can match on them.
+	value := lang.SymbolForName("_value", true)
+	if got := lang.SymbolMetadata(value); got != (sitter.SymbolMetadata{Named: true, Supertype: true}) {
+		t.Errorf("SymbolMetadata(_value) = %+v", got)
+	}
+
+	var fields []string
+	for _, f := range lang.Fields() {
+		fields = append(fields, f.Name)
+		if got := lang.FieldIDForName(f.Name); got != f.ID {
+			t.Errorf("FieldIDForName(%q) = %d; want %d", f.Name, got, f.ID)
+		}
+	}
+	if want := []string{"key", "value"}; !reflect.DeepEqual(fields, want) {
+		t.Errorf("Fields() = %q; want %q", fields, want)
+	}
+	if got := lang.FieldCount(); got != 2 {
+		t.Errorf("FieldCount() = %d; want 2", got)
+	}
+	if got := lang.FieldIDForName("nope"); got != 0 {
+		t.Errorf("FieldIDForName(\"nope\") = %d; want 0", got)
+	}
+
+	// Every node in a tree has one of the node types.
+	types := make(map[sitter.Symbol]sitter.NodeType)
+	for _, nt := range lang.NodeTypes() {
+		types[nt.Symbol] = nt
+	}
+	if nt := types[value]; nt != (sitter.NodeType{Symbol: value, Name: "_value", Named: true, Supertype: true}) {
+		t.Errorf("node type of _value = %+v", nt)
+	}
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(lang)
+	tree := parser.Parse(nil, []byte(`{"a": [1, true, null, "\n"]}`))
+	defer tree.Close()
+	var walk func(n *sitter.Node)
+	walk = func(n *sitter.Node) {
+		if nt, ok := types[n.Symbol()]; !ok || nt.Name != n.Type() || nt.Named != n.IsNamed() || nt.Supertype {
+			t.Errorf("node %s (symbol %d) has node type %+v", n.Type(), n.Symbol(), nt)
+		}
+		for i := 0; i < int(n.ChildCount()); i++ {
+			walk(n.Child(i))
+		}
+	}
+	walk(tree.RootNode())
+}
+
+func TestQueryPredicates(t *testing.T) {
+	const src = `{"name": "x", "Name": "y", "id": 1, "tags": 2, "todo": 3}`
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(json.GetLanguage())
+	tree := parser.Parse(nil, []byte(src))
+	defer tree.Close()
+
+	sitter.RegisterPredicate("longer-than?", func(p sitter.QueryPredicate, m *sitter.QueryMatch, input []byte) bool {
+		for _, c := range m.Captures {
+			if c.Index == p.Args[0].CaptureID && len(c.Node.Content(input)) <= len(p.Args[1].Value) {
+				return false
+			}
+		}
+		return true
+	})
+
+	const keyPattern = `(pair key: (string (string_content) @key) value: (_) @value`
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{keyPattern + ` (#eq? @key "name"))`, []string{"name"}},
+		{keyPattern + ` (#not-eq? @key "name"))`, []string{"Name", "id", "tags", "todo"}},
+		{keyPattern + ` (#eq? @key @value))`, nil},
+		{keyPattern + ` (#match? @key "^[a-z]+$"))`, []string{"name", "id", "tags", "todo"}},
+		{keyPattern + ` (#not-match? @key "^t"))`, []string{"name", "Name", "id"}},
+		{keyPattern + ` (#any-of? @key "id" "todo" "missing"))`, []string{"id", "todo"}},
+		{keyPattern + ` (#not-any-of? @key "id" "todo"))`, []string{"name", "Name", "tags"}},
+		{keyPattern + ` (#longer-than? @key "abc"))`, []string{"name", "Name", "tags", "todo"}},
+		{keyPattern + ` (#unknown? @key))`, []string{"name", "Name", "id", "tags", "todo"}},
+		{keyPattern + ` (#match? @key "^t") (#not-eq? @key "tags"))`, []string{"todo"}},
+	}
+	for _, test := range tests {
+		q, err := sitter.NewQuery([]byte(test.query), json.GetLanguage())
+		if err != nil {
+			t.Errorf("NewQuery(%q): %v", test.query, err)
+			continue
+		}
+		qc := sitter.NewQueryCursor()
+		qc.Exec(q, tree.RootNode())
+		var got []string
+		for {
+			m, ok := qc.NextFilteredMatch([]byte(src))
+			if !ok {
+				break
+			}
+			got = append(got, m.Captures[0].Node.Content([]byte(src)))
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("matches of %q = %q; want %q", test.query, got, test.want)
+		}
+		qc.Close()
+		q.Close()
+	}
+}
+
+func TestQueryPropertyDirectives(t *testing.T) {
+	const src = `{"a": 1}`
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(json.GetLanguage())
+	tree := parser.Parse(nil, []byte(src))
+	defer tree.Close()
+
+	q, err := sitter.NewQuery([]byte(`((number) @n
+		(#set! kind "int")
+		(#set! @n local)
+		(#is? @n constant)
+		(#is-not? exported))`), json.GetLanguage())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer q.Close()
+	if got := q.Predicates(0); len(got) != 4 || got[0].Operator != "set!" || got[3].Operator != "is-not?" {
+		t.Errorf("Predicates(0) = %+v; want set!, set!, is?, is-not?", got)
+	}
+
+	qc := sitter.NewQueryCursor()
+	defer qc.Close()
+	qc.Exec(q, tree.RootNode())
+	m, ok := qc.NextFilteredMatch([]byte(src))
+	if !ok {
+		t.Fatal("no match")
+	}
+	wantProps := []sitter.QueryProperty{
+		{Key: "kind", Value: "int"},
+		{Key: "local", HasCapture: true, CaptureID: 0},
+	}
+	if !reflect.DeepEqual(m.Properties, wantProps) {
+		t.Errorf("Properties = %+v; want %+v", m.Properties, wantProps)
+	}
+	wantPreds := []sitter.QueryPropertyPredicate{
+		{Property: sitter.QueryProperty{Key: "constant", HasCapture: true, CaptureID: 0}, IsPositive: true},
+		{Property: sitter.QueryProperty{Key: "exported"}, IsPositive: false},
+	}
+	if !reflect.DeepEqual(m.PropertyPredicates, wantPreds) {
+		t.Errorf("PropertyPredicates = %+v; want %+v", m.PropertyPredicates, wantPreds)
+	}
+}
+
+func TestQueryPredicateErrors(t *testing.T) {
+	for _, query := range []string{
+		`((number) @n (#eq? @n))`,
+		`((number) @n (#eq? "a" @n))`,
+		`((number) @n (#match? @n @n))`,
+		`((number) @n (#match? @n "("))`,
+		`((number) @n (#any-of? @n))`,
+		`((number) @n (#set!))`,
+		`(null) ((number) @n (#is? @n a b c))`,
+	} {
+		_, err := sitter.NewQuery([]byte(query), json.GetLanguage())
+		var qerr *sitter.QueryError
+		if !errors.As(err, &qerr) || qerr.Type != sitter.QueryErrorPredicate {
+			t.Errorf("NewQuery(%q) = %v; want predicate error", query, err)
+			continue
+		}
+		if want := strings.LastIndex(query, "((number)"); qerr.Offset != uint32(want) {
+			t.Errorf("NewQuery(%q) error offset = %d; want %d", query, qerr.Offset, want)
+		}
+	}
+}
+
+func TestQueryCursorLimits(t *testing.T) {
+	src := "[" + strings.Repeat("1, ", 50) + "2]"
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(json.GetLanguage())
+	tree := parser.Parse(nil, []byte(src))
+	defer tree.Close()
+
+	countMatches := func(qc *sitter.QueryCursor) int {
+		n := 0
+		for {
+			if _, ok := qc.NextMatch(); !ok {
+				return n
+			}
+			n++
+		}
+	}
+
+	q, err := sitter.NewQuery([]byte("(array (number) @a (number) @b)"), json.GetLanguage())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer q.Close()
+	qc := sitter.NewQueryCursor()
+	defer qc.Close()
+
+	qc.Exec(q, tree.RootNode())
+	all := countMatches(qc)
+	if qc.DidExceedMatchLimit() {
+		t.Error("DidExceedMatchLimit() = true without a limit")
+	}
+
+	// A cursor reuses the memory of earlier matches regardless of the limit,
+	// so set it on a fresh one.
+	limited := sitter.NewQueryCursor()
+	defer limited.Close()
+	limited.SetMatchLimit(4)
+	if got := limited.MatchLimit(); got != 4 {
+		t.Errorf("MatchLimit() = %d; want 4", got)
+	}
+	limited.Exec(q, tree.RootNode())
+	if n := countMatches(limited); n >= all {
+		t.Errorf("%d matches with a match limit; want fewer than %d", n, all)
+	}
+	if !limited.DidExceedMatchLimit() {
+		t.Error("DidExceedMatchLimit() = false; want true")
+	}
+
+	numbers, err := sitter.NewQuery([]byte("(number) @n"), json.GetLanguage())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer numbers.Close()
+	qc.SetByteRange(1, 5)
+	qc.Exec(numbers, tree.RootNode())
+	if n := countMatches(qc); n != 2 {
+		t.Errorf("%d matches in byte range; want 2", n)
+	}
+}
+
+func TestQueryDisable(t *testing.T) {
+	const src = `{"a": 1, "b": null}`
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(json.GetLanguage())
+	tree := parser.Parse(nil, []byte(src))
+	defer tree.Close()
+
+	const query = "(pair key: (_) @key value: (number) @value)\n(null) @null"
+	q, err := sitter.NewQuery([]byte(query), json.GetLanguage())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer q.Close()
+	if got, want := q.StartByteForPattern(1), uint32(strings.Index(query, "(null)")); got != want {
+		t.Errorf("StartByteForPattern(1) = %d; want %d", got, want)
+	}
+	// Not every value is a number.
+	if q.StepIsDefinite(uint32(strings.Index(query, "(number)"))) {
+		t.Error("StepIsDefinite(number) = true; want false")
+	}
+	const delimQuery = `(object "{" "}")`
+	delims, err := sitter.NewQuery([]byte(delimQuery), json.GetLanguage())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer delims.Close()
+	// Every object has braces.
+	if !delims.StepIsDefinite(uint32(strings.Index(delimQuery, `"{"`))) {
+		t.Error(`StepIsDefinite("{") = false; want true`)
+	}
+
+	q.DisableCapture("key")
+	q.DisablePattern(1)
+	qc := sitter.NewQueryCursor()
+	defer qc.Close()
+	qc.Exec(q, tree.RootNode())
+	var got []string
+	for {
+		m, ok := qc.NextMatch()
+		if !ok {
+			break
+		}
+		for _, c := range m.Captures {
+			got = append(got, q.CaptureNameForId(c.Index)+"="+c.Node.Content([]byte(src)))
+		}
+	}
+	if want := []string{"value=1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("captures = %q; want %q", got, want)
+	}
+}
+
+func TestQueryCursorIterators(t *testing.T) {
+	const src = `{"a": 1, "b": [2, 3], "skip": 4}`
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(json.GetLanguage())
+	tree := parser.Parse(nil, []byte(src))
+	defer tree.Close()
+
+	q, err := sitter.NewQuery([]byte(`
+		((pair key: (string (string_content) @key) value: (_) @value)
+		 (#not-eq? @key "skip"))
+		(number) @number`), json.GetLanguage())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer q.Close()
+	qc := sitter.NewQueryCursor()
+	defer qc.Close()
+
+	var matches []string
+	for m := range qc.Matches(q, tree.RootNode(), []byte(src)) {
+		if key := m.Capture("key"); key != nil {
+			matches = append(matches, key.Content([]byte(src))+"="+m.Capture("value").Content([]byte(src)))
+		} else {
+			matches = append(matches, m.Capture("number").Content([]byte(src)))
+		}
+	}
+	wantMatches := []string{"a=1", "1", "b=[2, 3]", "2", "3", "4"}
+	if !reflect.DeepEqual(matches, wantMatches) {
+		t.Errorf("Matches = %q; want %q", matches, wantMatches)
+	}
+
+	var captures []string
+	for m, c := range qc.Captures(q, tree.RootNode(), []byte(src)) {
+		if m.Captures[0].Name == "number" && m.Capture("key") != nil {
+			t.Errorf("match %d has captures of both patterns", m.ID)
+		}
+		captures = append(captures, c.Name+":"+c.Node.Content([]byte(src)))
+	}
+	wantCaptures := []string{"key:a", "value:1", "number:1", "key:b", "value:[2, 3]", "number:2", "number:3", "number:4"}
+	if !reflect.DeepEqual(captures, wantCaptures) {
+		t.Errorf("Captures = %q; want %q", captures, wantCaptures)
+	}
+
+	// Breaking out of a loop stops the iteration and leaves the cursor usable.
+	n := 0
+	for range qc.Captures(q, tree.RootNode(), []byte(src)) {
+		n++
+		if n == 2 {
+			break
+		}
+	}
+	if n != 2 {
+		t.Errorf("ran %d iterations; want 2", n)
+	}
+	count := 0
+	for range qc.Matches(q, tree.RootNode(), []byte(src)) {
+		count++
+	}
+	if count != len(wantMatches) {
+		t.Errorf("%d matches after break; want %d", count, len(wantMatches))
+	}
+}
+
+func BenchmarkQueryCursorMatches(b *testing.B) {
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(json.GetLanguage())
+	tree := parser.Parse(nil, benchmarkSource)
+	defer tree.Close()
+	q, err := sitter.NewQuery([]byte("(pair key: (string) @key value: (array) @value)"), json.GetLanguage())
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer q.Close()
+	qc := sitter.NewQueryCursor()
+	defer qc.Close()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for m := range qc.Matches(q, tree.RootNode(), benchmarkSource) {
+			_ = m.Capture("value")
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package sitter
+
+import (
+	"io"
+	"os"
+	"syscall"
+
+	C "github.com/yourbase/treesitter/internal/lib"
+	"modernc.org/libc"
+)
+
+// PrintDotGraphs sets w as the destination for Graphviz DOT graphs
+// of the parse stack during subsequent parses.
+// Passing nil stops printing graphs.
+// The returned error is from creating the pipe to w
+// or from writing to the previously set writer.
+func (p *Parser) PrintDotGraphs(w io.Writer) error {
+	err := p.stopDotGraphs()
+	if w == nil {
+		return err
+	}
+
+	pipe, pipeErr := newDotPipe(w)
+	if pipeErr != nil {
+		return pipeErr
+	}
+	C.Xts_parser_print_dot_graphs(p.tls, p.c, pipe.fd)
+	p.dotGraphs = pipe
+	return err
+}
+
+// stopDotGraphs closes the parser's DOT graph file, if any,
+// and waits for its output to be written.
+func (p *Parser) stopDotGraphs() error {
+	if p.dotGraphs == nil {
+		return nil
+	}
+	C.Xts_parser_print_dot_graphs(p.tls, p.c, -1)
+	err := p.dotGraphs.wait()
+	p.dotGraphs = nil
+	return err
+}
+
+// WriteDot writes a Graphviz DOT graph of the tree to w.
+func (t *Tree) WriteDot(w io.Writer) error {
+	tls := getTLS()
+	defer putTLS(tls)
+	pipe, err := newDotPipe(w)
+	if err != nil {
+		return err
+	}
+	mode, err := libc.CString("w")
+	if err != nil {
+		syscall.Close(int(pipe.fd))
+		pipe.wait()
+		return err
+	}
+	defer libc.Xfree(tls, mode)
+
+	file := libc.Xfdopen(tls, pipe.fd, mode)
+	if file == 0 {
+		syscall.Close(int(pipe.fd))
+		pipe.wait()
+		return syscall.EINVAL
+	}
+	C.Xts_tree_print_dot_graph(tls, t.c, file)
+	libc.Xfclose(tls, file)
+	return pipe.wait()
+}
+
+// dotPipe is a pipe whose write end is given to C code to print DOT graphs to
+// and whose read end is copied to a Go writer.
+type dotPipe struct {
+	// fd is the write end of the pipe. It is owned by the C code.
+	fd   int32
+	done chan error
+}
+
+func newDotPipe(w io.Writer) (*dotPipe, error) {
+	var fds [2]int
+	if err := syscall.Pipe(fds[:]); err != nil {
+		return nil, os.NewSyscallError("pipe", err)
+	}
+	syscall.CloseOnExec(fds[0])
+	syscall.CloseOnExec(fds[1])
+
+	r := os.NewFile(uintptr(fds[0]), "|0")
+	p := &dotPipe{fd: int32(fds[1]), done: make(chan error, 1)}
+	go func() {
+		_, err := io.Copy(w, r)
+		if err != nil {
+			// Keep draining so that the C code never blocks on a full pipe.
+			io.Copy(io.Discard, r)
+		}
+		r.Close()
+		p.done <- err
+	}()
+	return p, nil
+}
+
+// wait waits for the write end of the pipe to be closed
+// and returns the error from copying to the writer.
+func (p *dotPipe) wait() error {
+	return <-p.done
+}
@@ -18,8 +18,24 @@ ensure_upstream() {
 ensure_upstream tree-sitter https://github.com/tree-sitter/tree-sitter.git v0.20.0
 ensure_upstream tree-sitter-json https://github.com/tree-sitter/tree-sitter-json.git v0.19.0
 ensure_upstream tree-sitter-python https://github.com/tree-sitter/tree-sitter-python.git v0.19.0
+ensure_upstream tree-sitter-go https://github.com/tree-sitter/tree-sitter-go.git 6204b7308a32e991a8daed2e9895a90be55a510a
+ensure_upstream tree-sitter-javascript https://github.com/tree-sitter/tree-sitter-javascript.git d767b1a276a4e80d7a6be30bada3070740ba4fa2 # v0.21.4
+ensure_upstream tree-sitter-typescript https://github.com/tree-sitter/tree-sitter-typescript.git 9f804be960f289a0acc59d8564acc16857b0b088 # v0.21.2
+ensure_upstream tree-sitter-yaml https://github.com/ikatyang/tree-sitter-yaml.git 6129a83eeec7d6070b1c0567ec7ce3509ead607c # v0.5.0
+ensure_upstream tree-sitter-toml https://github.com/ikatyang/tree-sitter-toml.git 474fbbec27e27d76b45aeaf9191e8acb13a699e2 # v0.5.1
+ensure_upstream tree-sitter-hcl https://github.com/MichaHoffmann/tree-sitter-hcl.git 9e3ec9848f28d26845ba300fd73c740459b83e9b
 
-go install modernc.org/ccgo/v3@v3.12.52
+# internal/lib, json and python were translated with ccgo v3.12.52, which
+# matches the modernc.org/libc version in go.mod, and the grammars added since
+# with ccgo v3.16.13. Each translation keeps the ccgo it was made with, so that
+# rerunning this script reproduces the checked-in files.
+CCGO_LIB=v3.12.52
+CCGO_GRAMMARS=v3.16.13
+ccgo_dir="$(mktemp -d)"
+trap 'rm -rf "$ccgo_dir"' EXIT
+for version in "$CCGO_LIB" "$CCGO_GRAMMARS"; do
+  GOBIN="$ccgo_dir/$version" go install "modernc.org/ccgo/v3@$version"
+done
 
 # Verify that gcc isn't secretly clang. This is a problem on macOS.
 CC="${CC:-gcc}"
@@ -38,8 +54,18 @@ if "$CC" --version 2> /dev/null | grep clang &> /dev/null || \
   exit 1
 fi
 
+# ccgo passes bool arguments to variadic functions without promoting them to
+# int, which libc.VaList rejects. Cast them explicitly so that printing DOT
+# graphs works.
+perl -pi -e 's/^(\s*)(ts_subtree_(?:has_changes|depends_on_column)\(\*self\),)$/$1(unsigned)$2/' \
+  upstream/tree-sitter/lib/src/subtree.c
+if [[ "$(grep -c '(unsigned)ts_subtree_' upstream/tree-sitter/lib/src/subtree.c)" != 2 ]]; then
+  echo "upstream/tree-sitter/lib/src/subtree.c doesn't match the DOT graph patch." 1>&2
+  exit 1
+fi
+
 mkdir -p internal/lib
-ccgo \
+"$ccgo_dir/$CCGO_LIB/ccgo" \
   -pkgname=lib \
   -export-defines '' \
   -export-enums '' \
@@ -53,28 +79,85 @@ ccgo \
   -I upstream/tree-sitter/lib/include \
   upstream/tree-sitter/lib/src/*.c
 
+# Grammars are translated for every supported target from a single host, which
+# ccgo selects through TARGET_GOOS and TARGET_GOARCH. This is only sound for C
+# code whose translation does not depend on the host's libc headers.
+TARGETS="linux/amd64 linux/arm darwin/amd64 darwin/arm64"
+
+# gen_parser translates with ccgo $CCGO_GRAMMARS, unless CCGO_VERSION says
+# otherwise.
 gen_parser() {
-  local name
+  local name target goos goarch
   name="$1"
   shift
   mkdir -p "internal/$name"
-  ccgo \
-    -pkgname="$name" \
-    -export-defines '' \
-    -export-enums '' \
-    -export-externs X \
-    -export-structs S \
-    -export-fields '' \
-    -export-typedefs '' \
-    -trace-translation-units \
-    -o "internal/$name/${name}_$(go env GOOS)_$(go env GOARCH).go" \
-    -I ./internal/lib \
-    -I upstream/tree-sitter/lib/include \
-    "$@"
+  for target in $TARGETS; do
+    goos="${target%/*}"
+    goarch="${target#*/}"
+    TARGET_GOOS="$goos" TARGET_GOARCH="$goarch" "$ccgo_dir/${CCGO_VERSION:-$CCGO_GRAMMARS}/ccgo" \
+      -pkgname="$name" \
+      -export-defines '' \
+      -export-enums '' \
+      -export-externs X \
+      -export-structs S \
+      -export-fields '' \
+      -export-typedefs '' \
+      -trace-translation-units \
+      -o "internal/$name/${name}_${goos}_${goarch}.go" \
+      -I ./internal/lib \
+      -I ./internal/patch \
+      -I upstream/tree-sitter/lib/include \
+      "$@"
+  done
 }
 
-gen_parser json \
+CCGO_VERSION="$CCGO_LIB" gen_parser json \
     upstream/tree-sitter-json/src/parser.c
-gen_parser python \
+# The python scanner calls libc's wide character classification functions,
+# whose wint_t parameter differs between targets, so it is translated for the
+# host only.
+CCGO_VERSION="$CCGO_LIB" TARGETS="$(go env GOOS)/$(go env GOARCH)" gen_parser python \
   upstream/tree-sitter-python/src/parser.c \
   internal/python/patch/scanner.c
+
+# tree-sitter-go, tree-sitter-javascript, tree-sitter-typescript and
+# tree-sitter-hcl are generated for language ABI 14, which only adds a table
+# of primary state IDs to the end of TSLanguage. tree-sitter v0.20.0 never
+# reads it, but refuses languages newer than ABI 13, so declare the older
+# version.
+perl -pi -e 's/^#define LANGUAGE_VERSION 14$/#define LANGUAGE_VERSION 13/' \
+  upstream/tree-sitter-go/src/parser.c \
+  upstream/tree-sitter-javascript/src/parser.c \
+  upstream/tree-sitter-typescript/typescript/src/parser.c \
+  upstream/tree-sitter-typescript/tsx/src/parser.c \
+  upstream/tree-sitter-hcl/src/parser.c
+gen_parser golang \
+  upstream/tree-sitter-go/src/parser.c
+gen_parser javascript \
+  upstream/tree-sitter-javascript/src/parser.c \
+  internal/javascript/patch/scanner.c
+gen_parser typescript \
+  upstream/tree-sitter-typescript/typescript/src/parser.c \
+  internal/typescript/patch/scanner.c
+gen_parser tsx \
+  upstream/tree-sitter-typescript/tsx/src/parser.c \
+  internal/tsx/patch/scanner.c
+gen_parser yaml \
+  upstream/tree-sitter-yaml/src/parser.c \
+  internal/yaml/patch/scanner.c
+gen_parser toml \
+  upstream/tree-sitter-toml/src/parser.c \
+  upstream/tree-sitter-toml/src/scanner.c
+gen_parser hcl \
+  upstream/tree-sitter-hcl/src/parser.c \
+  internal/hcl/patch/scanner.c
+
+# The typed node wrappers are generated from each grammar's node types.
+cp upstream/tree-sitter-json/src/node-types.json internal/json/node-types.json
+cp upstream/tree-sitter-python/src/node-types.json internal/python/node-types.json
+go generate ./json ./python
+
+# ccgo's output converts uintptr variables to unsafe.Pointer and leaves
+# unreachable gotos behind, so those two checks can never pass. Everything
+# else has to.
+go vet -unsafeptr=false -unreachable=false ./...
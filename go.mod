@@ -1,6 +1,6 @@
 module github.com/yourbase/treesitter
 
-go 1.17
+go 1.23
 
 require modernc.org/libc v1.11.53
 
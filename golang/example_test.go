@@ -0,0 +1,72 @@
+package golang_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/yourbase/treesitter/golang"
+	"github.com/yourbase/treesitter/highlight"
+)
+
+func ExampleTagger() {
+	src := []byte(`package shapes
+
+// Shape is a geometric shape.
+type Shape interface {
+	Area() float64
+}
+
+// Square is a square.
+type Square struct{ Side float64 }
+
+// Area returns the area of the square.
+func (s Square) Area() float64 { return s.Side * s.Side }
+
+// Total returns the sum of the areas of shapes.
+func Total(shapes []Shape) float64 {
+	var total float64
+	for _, s := range shapes {
+		total += s.Area()
+	}
+	return total
+}
+`)
+	tags, err := golang.Tagger().Tags(context.Background(), src)
+	if err != nil {
+		panic(err)
+	}
+	for tag := range tags {
+		if tag.IsDefinition {
+			fmt.Printf("%d: %s %s: %s\n", tag.NameRange.StartPoint.Row+1, tag.Kind, tag.Name, tag.Docs)
+		} else {
+			fmt.Printf("%d: %s reference to %s\n", tag.NameRange.StartPoint.Row+1, tag.Kind, tag.Name)
+		}
+	}
+	// Output:
+	// 4: interface Shape: Shape is a geometric shape.
+	// 5: type reference to float64
+	// 9: type Square: Square is a square.
+	// 9: type reference to float64
+	// 12: type reference to Square
+	// 12: method Area: Area returns the area of the square.
+	// 12: type reference to float64
+	// 15: function Total: Total returns the sum of the areas of shapes.
+	// 15: type reference to Shape
+	// 15: type reference to float64
+	// 16: type reference to float64
+	// 18: call reference to Area
+}
+
+func ExampleHighlighter() {
+	src := []byte("x := len(\"a\\n\") // 2\n")
+	events, err := golang.Highlighter().Highlight(context.Background(), src)
+	if err != nil {
+		panic(err)
+	}
+	if err := highlight.WriteHTML(os.Stdout, src, events); err != nil {
+		panic(err)
+	}
+	// Output:
+	// <span class="variable">x</span> <span class="operator">:=</span> <span class="function builtin">len</span>(<span class="string">&#34;a<span class="escape">\n</span>&#34;</span>) <span class="comment">// 2</span>
+}
@@ -0,0 +1,14 @@
+package golang
+
+import (
+	sitter "github.com/yourbase/treesitter"
+	"github.com/yourbase/treesitter/internal/golang"
+	"github.com/yourbase/treesitter/internal/lang"
+	"github.com/yourbase/treesitter/internal/tlspool"
+)
+
+func GetLanguage() *sitter.Language {
+	tls := tlspool.Get()
+	defer tlspool.Put(tls)
+	return lang.NewLanguage(golang.Xtree_sitter_go(tls))
+}
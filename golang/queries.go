@@ -0,0 +1,48 @@
+package golang
+
+import (
+	_ "embed"
+
+	"github.com/yourbase/treesitter/highlight"
+	"github.com/yourbase/treesitter/internal/grammar"
+	"github.com/yourbase/treesitter/languages"
+	"github.com/yourbase/treesitter/tags"
+)
+
+// HighlightsQuery is adapted from tree-sitter-go's highlights query.
+// Calls of builtin functions come before other calls, and the builtins include
+// clear, max and min from Go 1.21. The operators also include &^ and &^=.
+//
+//go:embed queries/highlights.scm
+var HighlightsQuery []byte
+
+// TagsQuery is a tags query for definitions of functions, methods, interfaces and types,
+// with their doc comments, and references to them in calls and types.
+//
+//go:embed queries/tags.scm
+var TagsQuery []byte
+
+var (
+	highlighter = grammar.Lazy(highlight.New, GetLanguage, HighlightsQuery)
+	tagger      = grammar.Lazy(tags.New, GetLanguage, TagsQuery)
+)
+
+// Highlighter returns a highlighter using HighlightsQuery.
+func Highlighter() *highlight.Highlighter {
+	return highlighter()
+}
+
+// Tagger returns a tagger using TagsQuery.
+func Tagger() *tags.Tagger {
+	return tagger()
+}
+
+func init() {
+	languages.Register(&languages.Language{
+		Name:            "go",
+		GetLanguage:     GetLanguage,
+		Extensions:      []string{".go"},
+		HighlightsQuery: HighlightsQuery,
+		TagsQuery:       TagsQuery,
+	})
+}
@@ -0,0 +1,58 @@
+package hcl_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/yourbase/treesitter/hcl"
+	"github.com/yourbase/treesitter/highlight"
+)
+
+func ExampleTagger() {
+	src := []byte(`terraform {
+  required_version = ">= 1.0"
+}
+
+variable "region" {
+  type = string
+}
+
+resource "aws_instance" "web" {
+  ami  = lookup(var.amis, var.region)
+  tags = merge(local.tags, { Name = "web" })
+}
+`)
+	tags, err := hcl.Tagger().Tags(context.Background(), src)
+	if err != nil {
+		panic(err)
+	}
+	for tag := range tags {
+		if tag.IsDefinition {
+			fmt.Printf("%d: %s %s\n", tag.NameRange.StartPoint.Row+1, tag.Kind, tag.Name)
+		} else {
+			fmt.Printf("%d: %s reference to %s\n", tag.NameRange.StartPoint.Row+1, tag.Kind, tag.Name)
+		}
+	}
+	// Output:
+	// 1: block terraform
+	// 5: block region
+	// 9: block web
+	// 10: call reference to lookup
+	// 11: call reference to merge
+}
+
+func ExampleHighlighter() {
+	src := []byte("variable \"region\" {\n  default = upper(\"eu-${var.zone}\")\n}\n")
+	events, err := hcl.Highlighter().Highlight(context.Background(), src)
+	if err != nil {
+		panic(err)
+	}
+	if err := highlight.WriteHTML(os.Stdout, src, events); err != nil {
+		panic(err)
+	}
+	// Output:
+	// <span class="keyword">variable</span> <span class="string">&#34;region&#34;</span> <span class="punctuation bracket">{</span>
+	//   <span class="property">default</span> <span class="operator">=</span> <span class="function">upper</span><span class="punctuation bracket">(</span><span class="string">&#34;eu-<span class="punctuation special">${</span><span class="variable builtin">var</span><span class="punctuation delimiter">.</span><span class="property">zone</span><span class="punctuation special">}</span>&#34;</span><span class="punctuation bracket">)</span>
+	// <span class="punctuation bracket">}</span>
+}
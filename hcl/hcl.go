@@ -0,0 +1,14 @@
+package hcl
+
+import (
+	sitter "github.com/yourbase/treesitter"
+	"github.com/yourbase/treesitter/internal/hcl"
+	"github.com/yourbase/treesitter/internal/lang"
+	"github.com/yourbase/treesitter/internal/tlspool"
+)
+
+func GetLanguage() *sitter.Language {
+	tls := tlspool.Get()
+	defer tlspool.Put(tls)
+	return lang.NewLanguage(hcl.Xtree_sitter_hcl(tls))
+}
@@ -0,0 +1,48 @@
+package hcl
+
+import (
+	_ "embed"
+
+	"github.com/yourbase/treesitter/highlight"
+	"github.com/yourbase/treesitter/internal/grammar"
+	"github.com/yourbase/treesitter/languages"
+	"github.com/yourbase/treesitter/tags"
+)
+
+// HighlightsQuery is a highlights query for blocks, attributes, expressions and templates.
+// Terraform's block types, type constraints and named values, like resource,
+// string and var, are highlighted as builtins.
+//
+//go:embed queries/highlights.scm
+var HighlightsQuery []byte
+
+// TagsQuery is a tags query for definitions of blocks, named by their last label,
+// and references to functions in calls.
+//
+//go:embed queries/tags.scm
+var TagsQuery []byte
+
+var (
+	highlighter = grammar.Lazy(highlight.New, GetLanguage, HighlightsQuery)
+	tagger      = grammar.Lazy(tags.New, GetLanguage, TagsQuery)
+)
+
+// Highlighter returns a highlighter using HighlightsQuery.
+func Highlighter() *highlight.Highlighter {
+	return highlighter()
+}
+
+// Tagger returns a tagger using TagsQuery.
+func Tagger() *tags.Tagger {
+	return tagger()
+}
+
+func init() {
+	languages.Register(&languages.Language{
+		Name:            "hcl",
+		GetLanguage:     GetLanguage,
+		Extensions:      []string{".hcl", ".tf", ".tfvars", ".nomad"},
+		HighlightsQuery: HighlightsQuery,
+		TagsQuery:       TagsQuery,
+	})
+}
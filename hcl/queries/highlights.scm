@@ -0,0 +1,96 @@
+; Builtins
+
+((identifier) @keyword
+ (#any-of? @keyword "data" "locals" "module" "output" "provider" "resource" "terraform" "variable"))
+
+((identifier) @type.builtin
+ (#any-of? @type.builtin "any" "bool" "list" "map" "number" "object" "set" "string" "tuple"))
+
+(variable_expr
+  (identifier) @variable.builtin
+  (#any-of? @variable.builtin "count" "each" "local" "module" "path" "self" "terraform" "var"))
+
+; Identifiers
+
+(block (identifier) @type)
+(function_call (identifier) @function)
+(attribute (identifier) @property)
+(object_elem
+  key: (expression (variable_expr (identifier) @property)))
+(get_attr (identifier) @property)
+(identifier) @variable
+
+; Literals
+
+(numeric_lit) @number
+(bool_lit) @constant.builtin
+(null_lit) @constant.builtin
+(comment) @comment
+
+[
+  (string_lit)
+  (quoted_template)
+  (heredoc_template)
+] @string
+
+[
+  (template_interpolation_start)
+  (template_interpolation_end)
+  (template_directive_start)
+  (template_directive_end)
+  (strip_marker)
+] @punctuation.special
+
+; Keywords
+
+[
+  "for"
+  "endfor"
+  "in"
+  "if"
+  "else"
+  "endif"
+] @keyword
+
+; Operators and punctuation
+
+[
+  "!"
+  "*"
+  "/"
+  "%"
+  "+"
+  "-"
+  ">"
+  ">="
+  "<"
+  "<="
+  "=="
+  "!="
+  "&&"
+  "||"
+  "="
+] @operator
+
+[
+  (ellipsis)
+  "?"
+  ":"
+  "=>"
+] @punctuation.special
+
+[
+  "."
+  ".*"
+  ","
+  "[*]"
+] @punctuation.delimiter
+
+[
+  "("
+  ")"
+  "["
+  "]"
+  "{"
+  "}"
+] @punctuation.bracket
@@ -0,0 +1,15 @@
+; Blocks are named by their last label, like "web" in resource "aws_instance" "web",
+; or by their type if they don't have any, like terraform.
+
+(block
+  (string_lit (template_literal) @name)
+  .
+  (block_start)) @definition.block
+
+(block
+  (identifier) @name
+  .
+  (block_start)) @definition.block
+
+(function_call
+  (identifier) @name) @reference.call
@@ -0,0 +1,231 @@
+// Package highlight provides syntax highlighting driven by tree-sitter highlights queries,
+// like the highlights.scm files distributed with grammars.
+//
+// Each capture in a highlights query names a scope, like "function.builtin".
+// Highlighting a source file produces a stream of events that mark
+// where each scope starts and ends, which can be rendered with WriteHTML or WriteANSI.
+package highlight
+
+import (
+	"context"
+	"iter"
+	"slices"
+	"strings"
+
+	sitter "github.com/yourbase/treesitter"
+)
+
+// EventType is the type of a highlight Event.
+type EventType int
+
+const (
+	// EventSource is a range of source code, within all the currently open scopes.
+	EventSource EventType = iota
+	// EventStart opens a scope.
+	EventStart
+	// EventEnd closes the most recently opened scope.
+	EventEnd
+)
+
+func (t EventType) String() string {
+	switch t {
+	case EventSource:
+		return "source"
+	case EventStart:
+		return "start"
+	case EventEnd:
+		return "end"
+	default:
+		return "unknown"
+	}
+}
+
+// Event is an event in a stream of highlight events.
+// Scopes are properly nested: every EventStart is followed by a matching EventEnd.
+type Event struct {
+	Type EventType
+	// Start and End are the byte offsets of the source code of an EventSource.
+	Start, End uint32
+	// Scope is the name of the scope opened by an EventStart, like "function.builtin".
+	Scope string
+}
+
+// Highlighter highlights source code of a language with a highlights query.
+// It is safe for concurrent use by multiple goroutines.
+type Highlighter struct {
+	lang  *sitter.Language
+	query *sitter.Query
+
+	parsers sitter.ParserPool
+}
+
+// New returns a Highlighter for the language with the given highlights query.
+// Captures whose name starts with an underscore are not highlighted,
+// so they can be used by predicates.
+// When several patterns capture the same node, the first one in the query wins,
+// so more specific patterns have to come before general ones like (identifier) @variable.
+func New(lang *sitter.Language, highlightsQuery []byte) (*Highlighter, error) {
+	q, err := sitter.NewQuery(highlightsQuery, lang)
+	if err != nil {
+		return nil, err
+	}
+	return &Highlighter{lang: lang, query: q}, nil
+}
+
+// Language returns the language the highlighter was created with.
+func (h *Highlighter) Language() *sitter.Language {
+	return h.lang
+}
+
+// Scopes returns the names of the scopes the highlighter can produce.
+func (h *Highlighter) Scopes() []string {
+	var scopes []string
+	for i := uint32(0); i < h.query.CaptureCount(); i++ {
+		if name := h.query.CaptureNameForId(i); isScope(name) {
+			scopes = append(scopes, name)
+		}
+	}
+	return scopes
+}
+
+// Highlight parses src and returns an iterator over its highlight events.
+// The events cover the whole of src.
+// The returned error is from ctx.
+func (h *Highlighter) Highlight(ctx context.Context, src []byte) (iter.Seq[Event], error) {
+	parser := h.parsers.Get(h.lang)
+	defer h.parsers.Put(parser)
+	tree, err := parser.ParseContext(ctx, nil, src)
+	if err != nil {
+		return nil, err
+	}
+	defer tree.Close()
+	spans := slices.Collect(h.spans(tree, src))
+	return events(slices.Values(spans), uint32(len(src))), nil
+}
+
+// HighlightTree returns an iterator over the highlight events of src,
+// which tree was parsed from.
+func (h *Highlighter) HighlightTree(tree *sitter.Tree, src []byte) iter.Seq[Event] {
+	return events(h.spans(tree, src), uint32(len(src)))
+}
+
+// span is a range of source code to highlight with a scope.
+type span struct {
+	start, end uint32
+	scope      string
+}
+
+// spans returns an iterator over the highlighted ranges of src, ordered by start byte.
+func (h *Highlighter) spans(tree *sitter.Tree, src []byte) iter.Seq[span] {
+	return func(yield func(span) bool) {
+		qc := sitter.NewQueryCursor()
+		defer qc.Close()
+
+		var last *sitter.Node
+		for _, c := range qc.Captures(h.query, tree.RootNode(), src) {
+			if !isScope(c.Name) {
+				continue
+			}
+			// When a node is captured by several patterns, the first one wins.
+			if last != nil && c.Node.Equal(last) {
+				continue
+			}
+			last = c.Node
+			if !yield(span{c.Node.StartByte(), c.Node.EndByte(), c.Name}) {
+				return
+			}
+		}
+	}
+}
+
+// events returns an iterator over the events that highlight spans
+// in source code that ends at end.
+func events(spans iter.Seq[span], end uint32) iter.Seq[Event] {
+	return func(yield func(Event) bool) {
+		e := emitter{yield: yield}
+		for s := range spans {
+			if !e.start(s.start, s.end, s.scope) {
+				return
+			}
+		}
+		e.finish(end)
+	}
+}
+
+func isScope(captureName string) bool {
+	return !strings.HasPrefix(captureName, "_")
+}
+
+// emitter turns possibly overlapping ranges, ordered by start byte,
+// into a stream of properly nested events.
+type emitter struct {
+	yield func(Event) bool
+	// pos is the end of the source code emitted so far.
+	pos uint32
+	// ends holds the end byte of each open scope.
+	ends    []uint32
+	stopped bool
+}
+
+// start opens a scope for the given range, closing the scopes that end before it.
+// It reports whether the consumer wants more events.
+func (e *emitter) start(start, end uint32, scope string) bool {
+	if !e.closeUntil(start) {
+		return false
+	}
+	if start < e.pos {
+		// The range overlaps source code that has already been emitted.
+		start = e.pos
+	}
+	if n := len(e.ends); n > 0 && end > e.ends[n-1] {
+		// Keep scopes nested.
+		end = e.ends[n-1]
+	}
+	if start >= end {
+		return true
+	}
+	if !e.source(start) {
+		return false
+	}
+	e.ends = append(e.ends, end)
+	return e.emit(Event{Type: EventStart, Scope: scope})
+}
+
+// finish closes all the scopes and emits the rest of the source code up to end.
+func (e *emitter) finish(end uint32) {
+	if e.closeUntil(end) {
+		e.source(end)
+	}
+}
+
+// closeUntil closes the scopes that end at or before pos.
+func (e *emitter) closeUntil(pos uint32) bool {
+	for n := len(e.ends); n > 0 && e.ends[n-1] <= pos; n-- {
+		if !e.source(e.ends[n-1]) || !e.emit(Event{Type: EventEnd}) {
+			return false
+		}
+		e.ends = e.ends[:n-1]
+	}
+	return true
+}
+
+// source emits the source code from the current position up to end.
+func (e *emitter) source(end uint32) bool {
+	if end <= e.pos {
+		return true
+	}
+	start := e.pos
+	e.pos = end
+	return e.emit(Event{Type: EventSource, Start: start, End: end})
+}
+
+func (e *emitter) emit(ev Event) bool {
+	if e.stopped {
+		return false
+	}
+	if !e.yield(ev) {
+		e.stopped = true
+		return false
+	}
+	return true
+}
@@ -0,0 +1,112 @@
+package highlight_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/yourbase/treesitter/highlight"
+	"github.com/yourbase/treesitter/json"
+	"github.com/yourbase/treesitter/python"
+)
+
+func TestHighlightEvents(t *testing.T) {
+	src := []byte(`{"a\n": null}`)
+	events, err := json.Highlighter().Highlight(context.Background(), src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []highlight.Event
+	for ev := range events {
+		got = append(got, ev)
+	}
+	want := []highlight.Event{
+		{Type: highlight.EventSource, Start: 0, End: 1},
+		{Type: highlight.EventStart, Scope: "string.special.key"},
+		{Type: highlight.EventSource, Start: 1, End: 3},
+		{Type: highlight.EventStart, Scope: "escape"},
+		{Type: highlight.EventSource, Start: 3, End: 5},
+		{Type: highlight.EventEnd},
+		{Type: highlight.EventSource, Start: 5, End: 6},
+		{Type: highlight.EventEnd},
+		{Type: highlight.EventSource, Start: 6, End: 8},
+		{Type: highlight.EventStart, Scope: "constant.builtin"},
+		{Type: highlight.EventSource, Start: 8, End: 12},
+		{Type: highlight.EventEnd},
+		{Type: highlight.EventSource, Start: 12, End: 13},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("events = %+v; want %+v", got, want)
+	}
+
+	// Stopping early doesn't emit more events.
+	n := 0
+	for range events {
+		n++
+		if n == 3 {
+			break
+		}
+	}
+	if n != 3 {
+		t.Errorf("got %d events before break; want 3", n)
+	}
+}
+
+func TestHighlightCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	src := []byte(strings.Repeat("x = [1, 2, 3]\n", 1000))
+	if _, err := python.Highlighter().Highlight(ctx, src); !errors.Is(err, context.Canceled) {
+		t.Errorf("Highlight with canceled context = %v; want %v", err, context.Canceled)
+	}
+}
+
+func TestWriteHTML(t *testing.T) {
+	src := []byte("def f(x):\n    return len(x) < 2  # <short>\n")
+	events, err := python.Highlighter().Highlight(context.Background(), src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sb strings.Builder
+	if err := highlight.WriteHTML(&sb, src, events); err != nil {
+		t.Fatal(err)
+	}
+	const want = `<span class="keyword">def</span> <span class="function">f</span>(<span class="variable">x</span>):` + "\n" +
+		`    <span class="keyword">return</span> <span class="function builtin">len</span>(<span class="variable">x</span>) ` +
+		`<span class="operator">&lt;</span> <span class="number">2</span>  <span class="comment"># &lt;short&gt;</span>` + "\n"
+	if got := sb.String(); got != want {
+		t.Errorf("WriteHTML:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestWriteANSI(t *testing.T) {
+	src := []byte(`{"k": "a\tb", "n": 1}`)
+	events, err := json.Highlighter().Highlight(context.Background(), src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	theme := highlight.Theme{
+		"string":             "32",
+		"string.special.key": "1",
+		"escape":             "36",
+	}
+	var sb strings.Builder
+	if err := highlight.WriteANSI(&sb, src, events, theme); err != nil {
+		t.Fatal(err)
+	}
+	// Numbers aren't in the theme, so they aren't styled.
+	want := "{\x1b[0m\x1b[1m\"k\"\x1b[0m: \x1b[0m\x1b[32m\"a\x1b[0m\x1b[36m\\t\x1b[0m\x1b[32mb\"\x1b[0m, \x1b[0m\x1b[1m\"n\"\x1b[0m: 1}"
+	if got := sb.String(); got != want {
+		t.Errorf("WriteANSI = %q; want %q", got, want)
+	}
+}
+
+func TestScopes(t *testing.T) {
+	got := json.Highlighter().Scopes()
+	want := []string{"string.special.key", "string", "number", "constant.builtin", "escape"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Scopes() = %q; want %q", got, want)
+	}
+}
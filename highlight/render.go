@@ -0,0 +1,146 @@
+package highlight
+
+import (
+	"html"
+	"io"
+	"iter"
+	"strings"
+)
+
+// WriteHTML writes src to w as HTML, wrapping the source code of each scope
+// in a span element whose classes are the dot-separated parts of the scope's name.
+// For example, the scope "function.builtin" becomes <span class="function builtin">.
+func WriteHTML(w io.Writer, src []byte, events iter.Seq[Event]) error {
+	ew := &errWriter{w: w}
+	for ev := range events {
+		switch ev.Type {
+		case EventSource:
+			ew.WriteString(html.EscapeString(string(src[ev.Start:ev.End])))
+		case EventStart:
+			ew.WriteString(`<span class="`)
+			ew.WriteString(html.EscapeString(strings.ReplaceAll(ev.Scope, ".", " ")))
+			ew.WriteString(`">`)
+		case EventEnd:
+			ew.WriteString("</span>")
+		}
+		if ew.err != nil {
+			return ew.err
+		}
+	}
+	return nil
+}
+
+// Theme maps scope names to ANSI Select Graphic Rendition parameters,
+// like "1;34" for bold blue.
+// A scope that isn't in the theme uses the entry of its longest dot-separated prefix,
+// so "function" applies to "function.builtin" unless the latter has its own entry.
+// Scopes without any entry keep the style of the enclosing scope.
+type Theme map[string]string
+
+// DefaultTheme is a Theme using the standard terminal colors.
+var DefaultTheme = Theme{
+	"attribute":           "3;33",
+	"comment":             "3;90",
+	"constant":            "33",
+	"constant.builtin":    "1;33",
+	"constructor":         "33",
+	"embedded":            "",
+	"escape":              "36",
+	"function":            "34",
+	"function.builtin":    "36",
+	"keyword":             "35",
+	"number":              "33",
+	"operator":            "1",
+	"property":            "31",
+	"punctuation.special": "35",
+	"string":              "32",
+	"string.special":      "36",
+	"string.special.key":  "31",
+	"type":                "36",
+	"variable.builtin":    "1",
+}
+
+// style returns the SGR parameters for the scope and whether the theme has any.
+func (t Theme) style(scope string) (string, bool) {
+	for {
+		if s, ok := t[scope]; ok {
+			return s, true
+		}
+		i := strings.LastIndexByte(scope, '.')
+		if i < 0 {
+			return "", false
+		}
+		scope = scope[:i]
+	}
+}
+
+// WriteANSI writes src to w with ANSI escape sequences that color each scope
+// according to theme. A nil theme is DefaultTheme.
+func WriteANSI(w io.Writer, src []byte, events iter.Seq[Event], theme Theme) error {
+	if theme == nil {
+		theme = DefaultTheme
+	}
+	ew := &errWriter{w: w}
+	// styles holds the style of each open scope.
+	var styles []string
+	current := ""
+	setStyle := func(s string) {
+		if s == current {
+			return
+		}
+		ew.WriteString("\x1b[0m")
+		if s != "" {
+			ew.WriteString("\x1b[" + s + "m")
+		}
+		current = s
+	}
+	for ev := range events {
+		switch ev.Type {
+		case EventSource:
+			ew.Write(src[ev.Start:ev.End])
+		case EventStart:
+			s, ok := theme.style(ev.Scope)
+			if !ok {
+				s = current
+			}
+			styles = append(styles, s)
+			setStyle(s)
+		case EventEnd:
+			styles = styles[:len(styles)-1]
+			s := ""
+			if len(styles) > 0 {
+				s = styles[len(styles)-1]
+			}
+			setStyle(s)
+		}
+		if ew.err != nil {
+			return ew.err
+		}
+	}
+	setStyle("")
+	return ew.err
+}
+
+// errWriter is a writer that remembers the first error and ignores later writes.
+type errWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (ew *errWriter) Write(p []byte) (int, error) {
+	if ew.err != nil {
+		return 0, ew.err
+	}
+	var n int
+	n, ew.err = ew.w.Write(p)
+	return n, ew.err
+}
+
+func (ew *errWriter) WriteString(s string) (int, error) {
+	if ew.err != nil {
+		return 0, ew.err
+	}
+	var n int
+	n, ew.err = io.WriteString(ew.w, s)
+	return n, ew.err
+}
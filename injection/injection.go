@@ -0,0 +1,440 @@
+// Package injection parses documents that embed code in other languages,
+// like JSON in a Python string or CSS in HTML, as a set of layered syntax trees.
+//
+// The embedded regions are found with injections queries, like the injections.scm files
+// distributed with grammars. The following captures and properties are recognized:
+//
+//   - @injection.content captures the nodes that contain the embedded code.
+//   - @injection.language captures a node whose text is the name of the embedded language.
+//     Alternatively, the name can be set with (#set! injection.language "name").
+//   - (#set! injection.combined) parses the content of all the matches of a pattern
+//     as a single document, like the script elements of an HTML file.
+//   - (#set! injection.include-children) includes the children of the content nodes,
+//     which are excluded by default.
+package injection
+
+import (
+	"context"
+	"iter"
+	"strings"
+	"sync"
+
+	sitter "github.com/yourbase/treesitter"
+)
+
+// maxDepth is the maximum nesting of injected layers, which stops languages
+// that inject themselves from recursing forever.
+const maxDepth = 32
+
+// Language is a language that can be the host of a Document or be injected into one.
+type Language struct {
+	// Name is the name used to inject the language, like "json".
+	Name     string
+	Language *sitter.Language
+	// Injections finds the code embedded in the language, if any.
+	Injections *sitter.Query
+}
+
+// NewLanguage returns a Language with the given injections query.
+// An empty query means that the language has no injections.
+func NewLanguage(name string, lang *sitter.Language, injectionsQuery []byte) (*Language, error) {
+	l := &Language{Name: name, Language: lang}
+	if len(injectionsQuery) > 0 {
+		q, err := sitter.NewQuery(injectionsQuery, lang)
+		if err != nil {
+			return nil, err
+		}
+		l.Injections = q
+	}
+	return l, nil
+}
+
+// Registry is a set of languages that can be injected, by name.
+// Names are case-insensitive.
+// It is safe for concurrent use by multiple goroutines.
+// The zero value is an empty registry ready to use.
+type Registry struct {
+	mu    sync.RWMutex
+	langs map[string]*Language
+}
+
+// Register adds l to the registry, replacing any language with the same name.
+func (r *Registry) Register(l *Language) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.langs == nil {
+		r.langs = make(map[string]*Language)
+	}
+	r.langs[strings.ToLower(l.Name)] = l
+}
+
+// Lookup returns the language with the given name, or nil if there is none.
+func (r *Registry) Lookup(name string) *Language {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.langs[strings.ToLower(name)]
+}
+
+// Layer is the syntax tree of one language in a Document.
+type Layer struct {
+	Language *Language
+	Tree     *sitter.Tree
+	// Ranges are the parts of the document the layer was parsed from.
+	// They are nil for the host layer, which spans the whole document.
+	Ranges []sitter.Range
+	// Parent is the layer the layer was injected into, or nil for the host layer.
+	Parent *Layer
+	// Depth is the number of layers between the layer and the host layer.
+	Depth int
+}
+
+// contains reports whether the layer includes the given byte range of the document.
+func (l *Layer) contains(start, end uint32) bool {
+	if l.Ranges == nil {
+		return true
+	}
+	for _, r := range l.Ranges {
+		if r.StartByte <= start && end <= r.EndByte {
+			return true
+		}
+	}
+	return false
+}
+
+// Document is a source file parsed as layers of syntax trees:
+// the host language and the languages injected into it, recursively.
+type Document struct {
+	host     *Language
+	registry *Registry
+	parser   *sitter.Parser
+
+	src    []byte
+	layers []*Layer
+}
+
+// NewDocument returns an empty document in the host language
+// whose injections are looked up in registry.
+// The document should be closed when it's no longer in use.
+func NewDocument(host *Language, registry *Registry) *Document {
+	return &Document{
+		host:     host,
+		registry: registry,
+		parser:   sitter.NewParser(),
+	}
+}
+
+// Close frees the document's syntax trees.
+func (d *Document) Close() {
+	for _, l := range d.layers {
+		l.Tree.Close()
+	}
+	d.layers = nil
+	d.parser.Close()
+}
+
+// Layers returns the layers of the document in the order they were discovered:
+// the host layer first, then each layer after the layer it was injected into.
+func (d *Document) Layers() []*Layer {
+	return d.layers
+}
+
+// HostLayer returns the layer of the host language,
+// or nil if the document hasn't been parsed.
+func (d *Document) HostLayer() *Layer {
+	if len(d.layers) == 0 {
+		return nil
+	}
+	return d.layers[0]
+}
+
+// LayerAt returns the most deeply nested layer that includes the given byte range,
+// or nil if the document hasn't been parsed.
+func (d *Document) LayerAt(start, end uint32) *Layer {
+	var found *Layer
+	for _, l := range d.layers {
+		if l.contains(start, end) && (found == nil || l.Depth > found.Depth) {
+			found = l
+		}
+	}
+	return found
+}
+
+// Edit updates the document's trees and layer ranges for an edit of the source code.
+// Call Parse with the new source code to parse the edited layers incrementally.
+func (d *Document) Edit(e sitter.EditInput) {
+	for _, l := range d.layers {
+		l.Tree.Edit(e)
+		for i := range l.Ranges {
+			l.Ranges[i] = editRange(l.Ranges[i], e)
+		}
+	}
+}
+
+// ApplyTextEdit replaces src[start:oldEnd] with replacement, updates the document
+// for the edit like Edit, and returns the new source code to pass to Parse.
+// src must be the source code the document currently reflects.
+// ApplyTextEdit panics if the offsets are out of order or out of range.
+func (d *Document) ApplyTextEdit(src []byte, start, oldEnd uint32, replacement []byte) (newSrc []byte) {
+	newSrc, e := sitter.TextEdit(src, start, oldEnd, replacement, sitter.InputEncodingUTF8)
+	d.Edit(e)
+	return newSrc
+}
+
+// Parse parses src, and the code injected into it, recursively.
+// If the document has already been parsed, the trees of the layers whose languages
+// and positions haven't changed are reused, so src must be the previous source code
+// updated with the edits passed to Edit since.
+// The returned error is from ctx.
+func (d *Document) Parse(ctx context.Context, src []byte) error {
+	type pending struct {
+		lang   *Language
+		ranges []sitter.Range
+		parent *Layer
+	}
+
+	old := d.layers
+	var layers []*Layer
+	queue := []pending{{lang: d.host}}
+	for len(queue) > 0 {
+		p := queue[0]
+		queue = queue[1:]
+
+		depth := 0
+		if p.parent != nil {
+			depth = p.parent.Depth + 1
+		}
+		var oldTree *sitter.Tree
+		if l := findLayer(old, p.lang, p.ranges, depth); l != nil {
+			oldTree = l.Tree
+		}
+		d.parser.SetLanguage(p.lang.Language)
+		d.parser.SetIncludedRanges(p.ranges)
+		tree, err := d.parser.ParseContext(ctx, oldTree, src)
+		if err != nil {
+			for _, l := range layers {
+				l.Tree.Close()
+			}
+			return err
+		}
+		l := &Layer{
+			Language: p.lang,
+			Tree:     tree,
+			Ranges:   p.ranges,
+			Parent:   p.parent,
+			Depth:    depth,
+		}
+		layers = append(layers, l)
+
+		if depth < maxDepth {
+			for _, inj := range d.injections(l, src) {
+				queue = append(queue, pending{lang: inj.lang, ranges: inj.ranges, parent: l})
+			}
+		}
+	}
+	d.parser.SetIncludedRanges(nil)
+
+	for _, l := range old {
+		l.Tree.Close()
+	}
+	d.src = src
+	d.layers = layers
+	return nil
+}
+
+// findLayer returns the layer of lang at the given depth whose first range
+// starts where ranges does, if any.
+func findLayer(layers []*Layer, lang *Language, ranges []sitter.Range, depth int) *Layer {
+	for _, l := range layers {
+		if l.Language != lang || l.Depth != depth {
+			continue
+		}
+		// Only the host layer, at depth 0, has no ranges.
+		if depth == 0 || l.Ranges[0].StartByte == ranges[0].StartByte {
+			return l
+		}
+	}
+	return nil
+}
+
+// injection is an injected language found in a layer.
+type injection struct {
+	lang   *Language
+	ranges []sitter.Range
+}
+
+// injections returns the injections of the layer's language found in the layer.
+func (d *Document) injections(l *Layer, src []byte) []*injection {
+	q := l.Language.Injections
+	if q == nil {
+		return nil
+	}
+	qc := sitter.NewQueryCursor()
+	defer qc.Close()
+
+	var injections []*injection
+	combined := make(map[uint16]*injection)
+	for m := range qc.Matches(q, l.Tree.RootNode(), src) {
+		var name string
+		var isCombined, includeChildren bool
+		for _, p := range m.Properties {
+			switch p.Key {
+			case "injection.language":
+				name = p.Value
+			case "injection.combined":
+				isCombined = true
+			case "injection.include-children":
+				includeChildren = true
+			}
+		}
+		var ranges []sitter.Range
+		for _, c := range m.Captures {
+			switch c.Name {
+			case "injection.language":
+				name = c.Node.Content(src)
+			case "injection.content":
+				ranges = append(ranges, contentRanges(c.Node, includeChildren)...)
+			}
+		}
+		lang := d.registry.Lookup(name)
+		if lang == nil || len(ranges) == 0 {
+			continue
+		}
+		if l.Ranges != nil {
+			ranges = intersectRanges(ranges, l.Ranges)
+		}
+
+		if !isCombined {
+			injections = append(injections, &injection{lang: lang, ranges: ranges})
+			continue
+		}
+		inj := combined[m.PatternIndex]
+		if inj == nil {
+			inj = &injection{lang: lang}
+			combined[m.PatternIndex] = inj
+			injections = append(injections, inj)
+		}
+		inj.ranges = append(inj.ranges, ranges...)
+	}
+
+	// Empty ranges would make the parser parse the whole document.
+	filtered := injections[:0]
+	for _, inj := range injections {
+		if len(inj.ranges) > 0 {
+			filtered = append(filtered, inj)
+		}
+	}
+	return filtered
+}
+
+// contentRanges returns the ranges of an injection.content node,
+// without its children unless includeChildren is true.
+func contentRanges(n *sitter.Node, includeChildren bool) []sitter.Range {
+	r := sitter.Range{
+		StartPoint: n.StartPoint(),
+		EndPoint:   n.EndPoint(),
+		StartByte:  n.StartByte(),
+		EndByte:    n.EndByte(),
+	}
+	if includeChildren {
+		return []sitter.Range{r}
+	}
+
+	var ranges []sitter.Range
+	for i := 0; i < int(n.ChildCount()); i++ {
+		child := n.Child(i)
+		if child.StartByte() > r.StartByte {
+			ranges = append(ranges, sitter.Range{
+				StartPoint: r.StartPoint,
+				EndPoint:   child.StartPoint(),
+				StartByte:  r.StartByte,
+				EndByte:    child.StartByte(),
+			})
+		}
+		r.StartPoint = child.EndPoint()
+		r.StartByte = child.EndByte()
+	}
+	if r.EndByte > r.StartByte {
+		ranges = append(ranges, r)
+	}
+	return ranges
+}
+
+// intersectRanges returns the parts of the sorted ranges a that are in the sorted ranges b.
+func intersectRanges(a, b []sitter.Range) []sitter.Range {
+	var result []sitter.Range
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		r := a[i]
+		if b[j].StartByte > r.StartByte {
+			r.StartByte, r.StartPoint = b[j].StartByte, b[j].StartPoint
+		}
+		if b[j].EndByte < r.EndByte {
+			r.EndByte, r.EndPoint = b[j].EndByte, b[j].EndPoint
+		}
+		if r.StartByte < r.EndByte {
+			result = append(result, r)
+		}
+		if a[i].EndByte < b[j].EndByte {
+			i++
+		} else {
+			j++
+		}
+	}
+	return result
+}
+
+// editRange returns the position of r after the edit e.
+func editRange(r sitter.Range, e sitter.EditInput) sitter.Range {
+	if r.EndByte >= e.OldEndIndex {
+		r.EndByte = e.NewEndIndex + (r.EndByte - e.OldEndIndex)
+		r.EndPoint = editPoint(r.EndPoint, e)
+	} else if r.EndByte > e.StartIndex {
+		r.EndByte = e.NewEndIndex
+		r.EndPoint = e.NewEndPoint
+	}
+	if r.StartByte >= e.OldEndIndex {
+		r.StartByte = e.NewEndIndex + (r.StartByte - e.OldEndIndex)
+		r.StartPoint = editPoint(r.StartPoint, e)
+	} else if r.StartByte > e.StartIndex {
+		r.StartByte = e.NewEndIndex
+		r.StartPoint = e.NewEndPoint
+	}
+	if r.StartByte > r.EndByte {
+		r.StartByte, r.StartPoint = r.EndByte, r.EndPoint
+	}
+	return r
+}
+
+// editPoint returns the position of p, which is after the edited text, after the edit e.
+func editPoint(p sitter.Point, e sitter.EditInput) sitter.Point {
+	if p.Row == e.OldEndPoint.Row {
+		return sitter.Point{Row: e.NewEndPoint.Row, Column: e.NewEndPoint.Column + p.Column - e.OldEndPoint.Column}
+	}
+	return sitter.Point{Row: e.NewEndPoint.Row + p.Row - e.OldEndPoint.Row, Column: p.Column}
+}
+
+// Matches runs a query on each layer and returns an iterator over the layers and their matches
+// that satisfy the query's predicates.
+// queryFor returns the query for a layer, or nil to skip the layer.
+//
+// The match is reused between iterations, so it is only valid until the loop body returns.
+func (d *Document) Matches(queryFor func(*Layer) *sitter.Query) iter.Seq2[*Layer, *sitter.QueryMatch] {
+	return func(yield func(*Layer, *sitter.QueryMatch) bool) {
+		qc := sitter.NewQueryCursor()
+		defer qc.Close()
+
+		for _, l := range d.layers {
+			q := queryFor(l)
+			if q == nil {
+				continue
+			}
+			for m := range qc.Matches(q, l.Tree.RootNode(), d.src) {
+				if !yield(l, m) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package injection_test
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	sitter "github.com/yourbase/treesitter"
+	"github.com/yourbase/treesitter/injection"
+	"github.com/yourbase/treesitter/json"
+	"github.com/yourbase/treesitter/python"
+)
+
+const pythonInjections = `
+((comment) @_comment
+ .
+ (expression_statement
+   (assignment right: (string) @injection.content))
+ (#match? @_comment "^# *json")
+ (#set! injection.language "json"))
+
+(call
+  function: (identifier) @injection.language
+  arguments: (argument_list (string) @injection.content))
+`
+
+func newDocument(t *testing.T) *injection.Document {
+	t.Helper()
+	py, err := injection.NewLanguage("python", python.GetLanguage(), []byte(pythonInjections))
+	if err != nil {
+		t.Fatal(err)
+	}
+	js, err := injection.NewLanguage("JSON", json.GetLanguage(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	registry := new(injection.Registry)
+	registry.Register(py)
+	registry.Register(js)
+	return injection.NewDocument(py, registry)
+}
+
+// layerTrees returns the language name and tree of each layer.
+func layerTrees(d *injection.Document) []string {
+	var trees []string
+	for _, l := range d.Layers() {
+		trees = append(trees, l.Language.Name+": "+l.Tree.RootNode().String())
+	}
+	return trees
+}
+
+func TestDocument(t *testing.T) {
+	const src = "# json\nconfig = '{\"a\": [1, 2]}'\n# not json\nx = '[3]'\njson(\"[true]\")\nunknown('4')\n"
+	d := newDocument(t)
+	defer d.Close()
+	if err := d.Parse(context.Background(), []byte(src)); err != nil {
+		t.Fatal(err)
+	}
+
+	layers := d.Layers()
+	got := layerTrees(d)[1:]
+	want := []string{
+		"JSON: (document (object (pair key: (string (string_content)) value: (array (number) (number)))))",
+		"JSON: (document (array (true)))",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("injected layers = %q; want %q", got, want)
+	}
+	if host := d.HostLayer(); host != layers[0] || host.Language.Name != "python" || host.Ranges != nil {
+		t.Errorf("HostLayer() = %+v; want python layer", host)
+	}
+	config := layers[1]
+	start := uint32(strings.Index(src, "{"))
+	end := uint32(strings.Index(src, "}")) + 1
+	wantRanges := []sitter.Range{{
+		StartPoint: sitter.Point{Row: 1, Column: start - 7},
+		EndPoint:   sitter.Point{Row: 1, Column: end - 7},
+		StartByte:  start,
+		EndByte:    end,
+	}}
+	if !reflect.DeepEqual(config.Ranges, wantRanges) {
+		t.Errorf("Ranges = %+v; want %+v", config.Ranges, wantRanges)
+	}
+	if config.Parent != layers[0] || config.Depth != 1 {
+		t.Errorf("Parent, Depth = %p, %d; want %p, 1", config.Parent, config.Depth, layers[0])
+	}
+	if l := d.LayerAt(start+1, start+2); l != config {
+		t.Errorf("LayerAt(%d, %d) = %+v; want config layer", start+1, start+2, l)
+	}
+	if l := d.LayerAt(0, 1); l != layers[0] {
+		t.Errorf("LayerAt(0, 1) = %+v; want host layer", l)
+	}
+
+	numbers, err := sitter.NewQuery([]byte("(number) @number"), json.GetLanguage())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer numbers.Close()
+	var texts []string
+	for l, m := range d.Matches(func(l *injection.Layer) *sitter.Query {
+		if l.Language.Name == "JSON" {
+			return numbers
+		}
+		return nil
+	}) {
+		texts = append(texts, l.Language.Name+":"+m.Captures[0].Node.Content([]byte(src)))
+	}
+	if want := []string{"JSON:1", "JSON:2"}; !reflect.DeepEqual(texts, want) {
+		t.Errorf("Matches = %q; want %q", texts, want)
+	}
+}
+
+func TestDocumentEdit(t *testing.T) {
+	src := []byte("# json\nconfig = '{\"a\": [1, 2]}'\n")
+	d := newDocument(t)
+	defer d.Close()
+	if err := d.Parse(context.Background(), src); err != nil {
+		t.Fatal(err)
+	}
+
+	edits := []struct {
+		old, new string
+	}{
+		// Before the injection.
+		{"# json\n", "# json\n"},
+		// Inside the injection.
+		{"2", "2, {}"},
+		// Removing the injection.
+		{"# json", "# yaml"},
+		// Adding it back.
+		{"# yaml", "# json"},
+	}
+	for _, e := range edits {
+		start := uint32(strings.Index(string(src), e.old))
+		oldEnd := start + uint32(len(e.old))
+		src = d.ApplyTextEdit(src, start, oldEnd, []byte(e.new))
+		if err := d.Parse(context.Background(), src); err != nil {
+			t.Fatal(err)
+		}
+
+		fresh := newDocument(t)
+		if err := fresh.Parse(context.Background(), src); err != nil {
+			t.Fatal(err)
+		}
+		got, want := layerTrees(d), layerTrees(fresh)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("after replacing %q with %q, layers = %q; want %q", e.old, e.new, got, want)
+		}
+		for i, l := range d.Layers() {
+			if i < len(fresh.Layers()) && !reflect.DeepEqual(l.Ranges, fresh.Layers()[i].Ranges) {
+				t.Errorf("after replacing %q with %q, layer %d ranges = %+v; want %+v", e.old, e.new, i, l.Ranges, fresh.Layers()[i].Ranges)
+			}
+		}
+		fresh.Close()
+	}
+}
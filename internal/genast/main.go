@@ -0,0 +1,382 @@
+// Command genast generates typed wrappers over syntax tree nodes
+// from the node-types.json file of a tree-sitter grammar.
+//
+// Usage:
+//
+//	genast -package NAME -o FILE node-types.json
+//
+// For each named node type, like function_definition, it generates a struct embedding *sitter.Node,
+// like FunctionDefinition, with a method for each of the node type's fields
+// and a Children method for its named children without a field.
+// A field whose values all have the same node type returns that type's wrapper,
+// and other fields return the *sitter.Node.
+// An optional field with a wrapper also reports whether the node has it,
+// since a wrapper around a missing child would look like any other.
+// It also generates a function like AsFunctionDefinition that checks the type of a node.
+//
+// Supertypes, like expression, are wrapped like other node types,
+// and their As function checks that the node has one of their subtypes.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	sitter "github.com/yourbase/treesitter"
+)
+
+// nodeType is an entry of node-types.json.
+type nodeType struct {
+	Type     string               `json:"type"`
+	Named    bool                 `json:"named"`
+	Subtypes []typeRef            `json:"subtypes"`
+	Fields   map[string]childInfo `json:"fields"`
+	Children *childInfo           `json:"children"`
+}
+
+type typeRef struct {
+	Type  string `json:"type"`
+	Named bool   `json:"named"`
+}
+
+// childInfo describes the children of a node type in a field or without a field.
+type childInfo struct {
+	Multiple bool      `json:"multiple"`
+	Required bool      `json:"required"`
+	Types    []typeRef `json:"types"`
+}
+
+func main() {
+	pkg := flag.String("package", "", "name of the generated package")
+	out := flag.String("o", "ast.go", "output file")
+	flag.Parse()
+	if *pkg == "" || flag.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: genast -package NAME [-o FILE] node-types.json")
+		os.Exit(2)
+	}
+	if err := run(*pkg, *out, flag.Arg(0)); err != nil {
+		fmt.Fprintln(os.Stderr, "genast:", err)
+		os.Exit(1)
+	}
+}
+
+func run(pkg, out, nodeTypesPath string) error {
+	data, err := os.ReadFile(nodeTypesPath)
+	if err != nil {
+		return err
+	}
+	src, err := generate(pkg, data)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(out, src, 0o666)
+}
+
+// generate returns the Go source of the wrappers for the node types in data,
+// the contents of a node-types.json file.
+func generate(pkg string, data []byte) ([]byte, error) {
+	var types []nodeType
+	if err := json.Unmarshal(data, &types); err != nil {
+		return nil, fmt.Errorf("parse node types: %w", err)
+	}
+	g := &generator{types: make(map[string]*nodeType), used: make(map[string]bool)}
+	for i := range types {
+		t := &types[i]
+		if !t.Named {
+			continue
+		}
+		if _, dup := g.types[t.Type]; dup {
+			return nil, fmt.Errorf("duplicate node type %q", t.Type)
+		}
+		g.types[t.Type] = t
+		g.names = append(g.names, t.Type)
+	}
+	sort.Strings(g.names)
+
+	goNames := make(map[string]string)
+	for _, name := range g.names {
+		goName := exportedName(name)
+		if other, dup := goNames[goName]; dup {
+			return nil, fmt.Errorf("node types %q and %q are both named %s in Go", other, name, goName)
+		}
+		goNames[goName] = name
+	}
+
+	fmt.Fprintf(&g.buf, "// Code generated by genast from node-types.json. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&g.buf, "package %s\n\n", pkg)
+	fmt.Fprintf(&g.buf, "import sitter %q\n", "github.com/yourbase/treesitter")
+	for _, name := range g.names {
+		if err := g.nodeType(g.types[name]); err != nil {
+			return nil, err
+		}
+	}
+	g.helpers()
+
+	src, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("format generated code: %w", err)
+	}
+	return src, nil
+}
+
+type generator struct {
+	// types maps the names of named node types to their entries.
+	types map[string]*nodeType
+	// names holds the names of the named node types in order.
+	names []string
+	// used holds the names of the helper functions the generated code calls.
+	used map[string]bool
+	buf  bytes.Buffer
+}
+
+func (g *generator) printf(format string, args ...any) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// nodeMethods is the method set of *sitter.Node, which wrappers embed.
+// Field methods with the same name get a Field suffix so they don't shadow them.
+var nodeMethods = func() map[string]bool {
+	m := make(map[string]bool)
+	t := reflect.TypeOf((*sitter.Node)(nil))
+	for i := 0; i < t.NumMethod(); i++ {
+		m[t.Method(i).Name] = true
+	}
+	return m
+}()
+
+func (g *generator) nodeType(t *nodeType) error {
+	goName := exportedName(t.Type)
+	if len(t.Subtypes) > 0 {
+		g.printf("\n// %s is a node whose type is a subtype of %s: %s.\n", goName, t.Type, typeList(t.Subtypes))
+	} else {
+		g.printf("\n// %s is %s node.\n", goName, article(t.Type))
+	}
+	g.printf("type %s struct {\n*sitter.Node\n}\n", goName)
+
+	if len(t.Subtypes) > 0 {
+		g.printf("\n// As%s returns n as %s if its type is a subtype of %s.\n", goName, article(goName), t.Type)
+		g.printf("func As%s(n *sitter.Node) (%s, bool) {\n", goName, goName)
+		g.printf("if n == nil || !n.IsNamed() {\nreturn %s{}, false\n}\n", goName)
+		g.printf("switch n.Type() {\ncase %s:\nreturn %s{n}, true\n}\n", quotedList(g.concreteSubtypes(t)), goName)
+		g.printf("return %s{}, false\n}\n", goName)
+		return nil
+	}
+
+	g.printf("\n// As%s returns n as %s if it is %s node.\n", goName, article(goName), article(t.Type))
+	g.printf("func As%s(n *sitter.Node) (%s, bool) {\n", goName, goName)
+	g.printf("if n == nil || !n.IsNamed() || n.Type() != %q {\nreturn %s{}, false\n}\n", t.Type, goName)
+	g.printf("return %s{n}, true\n}\n", goName)
+
+	fields := make([]string, 0, len(t.Fields))
+	for name := range t.Fields {
+		fields = append(fields, name)
+	}
+	sort.Strings(fields)
+	for _, name := range fields {
+		method := exportedName(name)
+		if nodeMethods[method] {
+			method += "Field"
+		}
+		if method == "Children" {
+			return fmt.Errorf("field %q of %s conflicts with the Children method", name, t.Type)
+		}
+		info := t.Fields[name]
+		if !info.Multiple && !info.Required && g.wrapper(info.Types) != "" {
+			g.printf("\n// %s returns the %s field of the %s (%s) and whether it has one.\n", method, name, t.Type, describe(info))
+		} else {
+			g.printf("\n// %s returns the %s field of the %s (%s).\n", method, name, t.Type, describe(info))
+		}
+		if info.Multiple {
+			g.used["childrenByFieldName"] = true
+		} else {
+			g.used["childByFieldName"] = true
+		}
+		g.accessor(goName, method, info, fmt.Sprintf("childrenByFieldName(n.Node, %q)", name), fmt.Sprintf("childByFieldName(n.Node, %q)", name))
+	}
+	if t.Children != nil && len(t.Children.Types) > 0 {
+		g.printf("\n// Children returns the named children of the %s that aren't in a field (%s).\n", t.Type, describe(*t.Children))
+		g.used["namedChildrenWithoutField"] = true
+		g.accessor(goName, "Children", childInfo{Multiple: true, Types: t.Children.Types}, "namedChildrenWithoutField(n.Node)", "")
+	}
+	return nil
+}
+
+// accessor generates a method that returns children of a node.
+// many and one are the expressions that return all the children or only the first one.
+func (g *generator) accessor(goName, method string, info childInfo, many, one string) {
+	wrapper := g.wrapper(info.Types)
+	switch {
+	case info.Multiple && wrapper == "":
+		g.printf("func (n %s) %s() []*sitter.Node {\nreturn %s\n}\n", goName, method, many)
+	case info.Multiple:
+		g.printf("func (n %s) %s() []%s {\n", goName, method, wrapper)
+		g.printf("nodes := %s\n", many)
+		g.printf("children := make([]%s, len(nodes))\n", wrapper)
+		g.printf("for i, c := range nodes {\nchildren[i] = %s{c}\n}\n", wrapper)
+		g.printf("return children\n}\n")
+	case wrapper == "":
+		g.printf("func (n %s) %s() *sitter.Node {\nreturn %s\n}\n", goName, method, one)
+	case !info.Required:
+		g.printf("func (n %s) %s() (%s, bool) {\n", goName, method, wrapper)
+		g.printf("c := %s\n", one)
+		g.printf("if c == nil {\nreturn %s{}, false\n}\n", wrapper)
+		g.printf("return %s{c}, true\n}\n", wrapper)
+	default:
+		g.printf("func (n %s) %s() %s {\nreturn %s{%s}\n}\n", goName, method, wrapper, wrapper, one)
+	}
+}
+
+// wrapper returns the name of the wrapper type for children of the given types,
+// or an empty string if they have no wrapper in common.
+func (g *generator) wrapper(types []typeRef) string {
+	if len(types) != 1 || !types[0].Named {
+		return ""
+	}
+	if _, ok := g.types[types[0].Type]; !ok {
+		return ""
+	}
+	return exportedName(types[0].Type)
+}
+
+// describe returns a phrase describing the children of a node in a field or without a field.
+func describe(info childInfo) string {
+	s := typeList(info.Types)
+	switch {
+	case info.Multiple && info.Required:
+		s = "one or more of " + s
+	case info.Multiple:
+		s = "any number of " + s
+	case !info.Required:
+		s += ", optional"
+	}
+	return s
+}
+
+// concreteSubtypes returns the names of the node types a supertype stands for,
+// including the subtypes of its subtypes.
+func (g *generator) concreteSubtypes(t *nodeType) []string {
+	seen := make(map[string]bool)
+	var names []string
+	var add func(t *nodeType)
+	add = func(t *nodeType) {
+		for _, sub := range t.Subtypes {
+			if !sub.Named || seen[sub.Type] {
+				continue
+			}
+			seen[sub.Type] = true
+			if st := g.types[sub.Type]; st != nil && len(st.Subtypes) > 0 {
+				add(st)
+				continue
+			}
+			names = append(names, sub.Type)
+		}
+	}
+	add(t)
+	sort.Strings(names)
+	return names
+}
+
+func (g *generator) helpers() {
+	for _, name := range []string{"childByFieldName", "childrenByFieldName", "namedChildrenWithoutField"} {
+		if g.used[name] {
+			g.printf("%s", helpers[name])
+		}
+	}
+}
+
+// helpers maps the names of the helper functions of generated code to their source.
+var helpers = map[string]string{
+	"childByFieldName": `
+// childByFieldName returns the child of n in the given field, if any.
+func childByFieldName(n *sitter.Node, name string) *sitter.Node {
+	if n == nil {
+		return nil
+	}
+	return n.ChildByFieldName(name)
+}
+`,
+	"childrenByFieldName": `
+// childrenByFieldName returns the children of n in the given field.
+func childrenByFieldName(n *sitter.Node, name string) []*sitter.Node {
+	if n == nil {
+		return nil
+	}
+	c := sitter.NewTreeCursor(n)
+	defer c.Close()
+	var children []*sitter.Node
+	for ok := c.GoToFirstChild(); ok; ok = c.GoToNextSibling() {
+		if c.CurrentFieldName() == name {
+			children = append(children, c.CurrentNode())
+		}
+	}
+	return children
+}
+`,
+	"namedChildrenWithoutField": `
+// namedChildrenWithoutField returns the named children of n that aren't in a field or extra, like comments.
+func namedChildrenWithoutField(n *sitter.Node) []*sitter.Node {
+	if n == nil {
+		return nil
+	}
+	c := sitter.NewTreeCursor(n)
+	defer c.Close()
+	var children []*sitter.Node
+	for ok := c.GoToFirstChild(); ok; ok = c.GoToNextSibling() {
+		if child := c.CurrentNode(); c.CurrentFieldName() == "" && child.IsNamed() && !child.IsExtra() {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+`,
+}
+
+// exportedName returns the exported Go name for a node type or field name,
+// like FunctionDefinition for function_definition.
+func exportedName(name string) string {
+	var sb strings.Builder
+	for _, part := range strings.Split(name, "_") {
+		if part == "" {
+			continue
+		}
+		sb.WriteString(strings.ToUpper(part[:1]))
+		sb.WriteString(part[1:])
+	}
+	return sb.String()
+}
+
+// article returns the name preceded by "a" or "an".
+func article(name string) string {
+	if strings.ContainsRune("AEIOUaeiou", rune(name[0])) {
+		return "an " + name
+	}
+	return "a " + name
+}
+
+// typeList returns the node types as a comma-separated list, with anonymous ones quoted.
+func typeList(types []typeRef) string {
+	names := make([]string, len(types))
+	for i, t := range types {
+		if t.Named {
+			names[i] = t.Type
+		} else {
+			names[i] = fmt.Sprintf("%q", t.Type)
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+func quotedList(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = fmt.Sprintf("%q", name)
+	}
+	return strings.Join(quoted, ", ")
+}
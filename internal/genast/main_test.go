@@ -0,0 +1,119 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	sitter "github.com/yourbase/treesitter"
+	sitterjson "github.com/yourbase/treesitter/json"
+	"github.com/yourbase/treesitter/python"
+)
+
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	for _, pkg := range []string{"json", "python"} {
+		data, err := os.ReadFile("../" + pkg + "/node-types.json")
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := generate(pkg, data)
+		if err != nil {
+			t.Fatalf("generate(%q): %v", pkg, err)
+		}
+		got, err := os.ReadFile("../../" + pkg + "/ast.go")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s/ast.go is out of date; run go generate ./%s", pkg, pkg)
+		}
+	}
+}
+
+// TestNodeTypesMatchGrammars checks the node types and fields of node-types.json
+// against the compiled grammars, which must have the same ones.
+func TestNodeTypesMatchGrammars(t *testing.T) {
+	for pkg, lang := range map[string]*sitter.Language{
+		"json":   sitterjson.GetLanguage(),
+		"python": python.GetLanguage(),
+	} {
+		data, err := os.ReadFile("../" + pkg + "/node-types.json")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var types []nodeType
+		if err := json.Unmarshal(data, &types); err != nil {
+			t.Fatal(err)
+		}
+
+		listed := make(map[typeRef]bool)
+		for _, nt := range types {
+			listed[typeRef{nt.Type, nt.Named}] = true
+			s := lang.SymbolForName(nt.Type, nt.Named)
+			if s == 0 {
+				t.Errorf("%s: node type %q (named: %t) isn't in the grammar", pkg, nt.Type, nt.Named)
+				continue
+			}
+			if got, want := lang.SymbolMetadata(s).Supertype, len(nt.Subtypes) > 0; got != want {
+				t.Errorf("%s: node type %q is a supertype: %t, want %t", pkg, nt.Type, got, want)
+			}
+			for name := range nt.Fields {
+				if lang.FieldIDForName(name) == 0 {
+					t.Errorf("%s: field %q of %q isn't in the grammar", pkg, name, nt.Type)
+				}
+			}
+		}
+		for _, nt := range lang.NodeTypes() {
+			if !listed[typeRef{nt.Name, nt.Named}] {
+				t.Errorf("%s: node type %q (named: %t) isn't in node-types.json", pkg, nt.Name, nt.Named)
+			}
+		}
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	const nodeTypes = `[
+		{"type": "_expression", "named": true, "subtypes": [
+			{"type": "identifier", "named": true},
+			{"type": "_literal", "named": true}
+		]},
+		{"type": "_literal", "named": true, "subtypes": [{"type": "number", "named": true}]},
+		{"type": "call", "named": true, "fields": {
+			"function": {"multiple": false, "required": true, "types": [{"type": "identifier", "named": true}]},
+			"receiver": {"multiple": false, "required": false, "types": [{"type": "identifier", "named": true}]},
+			"type": {"multiple": false, "required": false, "types": [{"type": "identifier", "named": true}, {"type": "number", "named": true}]},
+			"argument": {"multiple": true, "required": false, "types": [{"type": "_expression", "named": true}]}
+		}, "children": {"multiple": false, "required": false, "types": [{"type": "comment", "named": true}]}},
+		{"type": "comment", "named": true},
+		{"type": "identifier", "named": true},
+		{"type": "number", "named": true},
+		{"type": "(", "named": false}
+	]`
+	src, err := generate("calc", []byte(nodeTypes))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"type Expression struct",
+		`case "identifier", "number":`,
+		`case "number":`,
+		"func AsCall(n *sitter.Node) (Call, bool)",
+		"func (n Call) Function() Identifier",
+		"func (n Call) Receiver() (Identifier, bool)",
+		"func (n Call) Argument() []Expression",
+		// Type would shadow the Type method of *sitter.Node.
+		"func (n Call) TypeField() *sitter.Node",
+		"func (n Call) Children() []Comment",
+		"func namedChildrenWithoutField(",
+	} {
+		if !strings.Contains(string(src), want) {
+			t.Errorf("generated code doesn't contain %q:\n%s", want, src)
+		}
+	}
+
+	if _, err := generate("calc", []byte(`[{"type": "ab", "named": true}, {"type": "_ab", "named": true}]`)); err == nil {
+		t.Error("generate with conflicting Go names succeeded")
+	}
+}
@@ -0,0 +1,7 @@
+// Code generated by 'ccgo -pkgname=golang -export-defines  -export-enums  -export-externs X -export-structs S -export-fields  -export-typedefs  -trace-translation-units -o internal/golang/golang_darwin_amd64.go -I ./internal/lib -I upstream/tree-sitter/lib/include upstream/tree-sitter-go/src/parser.c', DO NOT EDIT.
+
+package golang
+
+var CAPI = map[string]struct{}{
+	"tree_sitter_go": {},
+}
@@ -0,0 +1,23 @@
+// Package grammar holds what the grammar packages share.
+package grammar
+
+import (
+	"sync"
+
+	sitter "github.com/yourbase/treesitter"
+)
+
+// Lazy returns a function that calls newFunc with the language returned by getLanguage
+// and query the first time it's called, and returns that result every time.
+// As the grammar packages embed their queries, it panics if newFunc fails.
+//
+// newFunc is one of highlight.New, locals.New and tags.New.
+func Lazy[T any](newFunc func(*sitter.Language, []byte) (T, error), getLanguage func() *sitter.Language, query []byte) func() T {
+	return sync.OnceValue(func() T {
+		v, err := newFunc(getLanguage(), query)
+		if err != nil {
+			panic(err)
+		}
+		return v
+	})
+}
@@ -0,0 +1,13 @@
+// Code generated by 'ccgo -pkgname=hcl -export-defines  -export-enums  -export-externs X -export-structs S -export-fields  -export-typedefs  -trace-translation-units -o internal/hcl/hcl_darwin_arm64.go -I ./internal/lib -I ./internal/patch -I upstream/tree-sitter/lib/include upstream/tree-sitter-hcl/src/parser.c internal/hcl/patch/scanner.c', DO NOT EDIT.
+
+package hcl
+
+var CAPI = map[string]struct{}{
+	"string_new":      {},
+	"tree_sitter_hcl": {},
+	"tree_sitter_hcl_external_scanner_create":      {},
+	"tree_sitter_hcl_external_scanner_deserialize": {},
+	"tree_sitter_hcl_external_scanner_destroy":     {},
+	"tree_sitter_hcl_external_scanner_scan":        {},
+	"tree_sitter_hcl_external_scanner_serialize":   {},
+}
@@ -0,0 +1,12 @@
+// Code generated by 'ccgo -pkgname=javascript -export-defines  -export-enums  -export-externs X -export-structs S -export-fields  -export-typedefs  -trace-translation-units -o internal/javascript/javascript_linux_arm.go -I ./internal/lib -I ./internal/patch -I upstream/tree-sitter/lib/include upstream/tree-sitter-javascript/src/parser.c internal/javascript/patch/scanner.c', DO NOT EDIT.
+
+package javascript
+
+var CAPI = map[string]struct{}{
+	"tree_sitter_javascript":                              {},
+	"tree_sitter_javascript_external_scanner_create":      {},
+	"tree_sitter_javascript_external_scanner_deserialize": {},
+	"tree_sitter_javascript_external_scanner_destroy":     {},
+	"tree_sitter_javascript_external_scanner_scan":        {},
+	"tree_sitter_javascript_external_scanner_serialize":   {},
+}
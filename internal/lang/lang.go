@@ -1,7 +1,10 @@
 package lang
 
 import (
+	"fmt"
+
 	C "github.com/yourbase/treesitter/internal/lib"
+	"github.com/yourbase/treesitter/internal/tlspool"
 	"modernc.org/libc"
 )
 
@@ -19,33 +22,161 @@ func LanguagePtr(lang *Language) uintptr {
 	return lang.ptr
 }
 
+// Version returns the ABI version of the tree-sitter library the language was generated for.
+func (l *Language) Version() uint32 {
+	tls := tlspool.Get()
+	defer tlspool.Put(tls)
+	return uint32(C.Xts_language_version(tls, l.ptr))
+}
+
 // SymbolName returns a node type string for the given Symbol.
 func (l *Language) SymbolName(s C.TSSymbol) string {
-	tls := libc.NewTLS()
-	defer tls.Close()
+	tls := tlspool.Get()
+	defer tlspool.Put(tls)
 	return libc.GoString(C.Xts_language_symbol_name(tls, l.ptr, s))
 }
 
 // SymbolType returns named, anonymous, or a hidden type for a Symbol.
 func (l *Language) SymbolType(s C.TSSymbol) SymbolType {
-	tls := libc.NewTLS()
-	defer tls.Close()
+	tls := tlspool.Get()
+	defer tlspool.Put(tls)
 	return SymbolType(C.Xts_language_symbol_type(tls, l.ptr, s))
 }
 
-// SymbolCount returns the number of distinct field names in the language.
+// SymbolCount returns the number of distinct symbols in the language.
 func (l *Language) SymbolCount() uint32 {
-	tls := libc.NewTLS()
-	defer tls.Close()
+	tls := tlspool.Get()
+	defer tlspool.Put(tls)
 	return uint32(C.Xts_language_symbol_count(tls, l.ptr))
 }
 
+// SymbolForName returns the Symbol of the named or anonymous node type with the given name,
+// or zero if the language has no such node type.
+func (l *Language) SymbolForName(name string, named bool) C.TSSymbol {
+	tls := tlspool.Get()
+	defer tlspool.Put(tls)
+	cname, err := libc.CString(name)
+	if err != nil {
+		panic(err)
+	}
+	defer libc.Xfree(tls, cname)
+	var isNamed uint8
+	if named {
+		isNamed = 1
+	}
+	return C.Xts_language_symbol_for_name(tls, l.ptr, cname, uint32(len(name)), isNamed)
+}
+
+// SymbolMetadata describes how nodes of a Symbol appear in syntax trees.
+type SymbolMetadata struct {
+	// Visible reports whether nodes of the symbol appear in trees.
+	// Hidden symbols, whose names start with an underscore, are inlined into their parents.
+	Visible bool
+	// Named reports whether nodes of the symbol are named, as opposed to anonymous tokens like "(".
+	Named bool
+	// Supertype reports whether the symbol is a hidden rule grouping other node types,
+	// like "expression", which queries can match on.
+	Supertype bool
+}
+
+// SymbolMetadata returns the metadata of a Symbol.
+func (l *Language) SymbolMetadata(s C.TSSymbol) SymbolMetadata {
+	tls := tlspool.Get()
+	defer tlspool.Put(tls)
+	m := C.Xts_language_symbol_metadata(tls, l.ptr, s)
+	return SymbolMetadata{
+		Visible:   m.Visible != 0,
+		Named:     m.Named != 0,
+		Supertype: m.Supertype != 0,
+	}
+}
+
+// FieldCount returns the number of distinct field names in the language.
+// Field IDs range from 1 to FieldCount.
+func (l *Language) FieldCount() uint32 {
+	tls := tlspool.Get()
+	defer tlspool.Put(tls)
+	return uint32(C.Xts_language_field_count(tls, l.ptr))
+}
+
+// FieldName returns the name of the field with the given ID,
+// or an empty string if the language has no such field.
 func (l *Language) FieldName(idx int) string {
-	tls := libc.NewTLS()
-	defer tls.Close()
+	tls := tlspool.Get()
+	defer tlspool.Put(tls)
 	return libc.GoString(C.Xts_language_field_name_for_id(tls, l.ptr, uint16(idx)))
 }
 
+// FieldIDForName returns the ID of the field with the given name,
+// or zero if the language has no such field.
+func (l *Language) FieldIDForName(name string) C.TSFieldId {
+	tls := tlspool.Get()
+	defer tlspool.Put(tls)
+	cname, err := libc.CString(name)
+	if err != nil {
+		panic(err)
+	}
+	defer libc.Xfree(tls, cname)
+	return C.Xts_language_field_id_for_name(tls, l.ptr, cname, uint32(len(name)))
+}
+
+// NodeType is a type of node of the language, like those in its node-types.json.
+type NodeType struct {
+	Symbol C.TSSymbol
+	Name   string
+	Named  bool
+	// Supertype reports whether the node type groups other node types.
+	// Nodes never have a supertype, but queries can match on them.
+	Supertype bool
+}
+
+// NodeTypes returns the node types of the language, ordered by Symbol:
+// the types of node that can appear in its syntax trees, including aliases,
+// and its supertypes.
+// Other hidden symbols are left out, as are aliases with the same name as
+// another symbol, since nodes report that symbol instead.
+func (l *Language) NodeTypes() []NodeType {
+	tls := tlspool.Get()
+	defer tlspool.Put(tls)
+	var types []NodeType
+	count := C.Xts_language_symbol_count(tls, l.ptr)
+	for i := uint32(0); i < count; i++ {
+		s := C.TSSymbol(i)
+		m := C.Xts_language_symbol_metadata(tls, l.ptr, s)
+		if m.Visible == 0 && m.Supertype == 0 || C.Xts_language_public_symbol(tls, l.ptr, s) != s {
+			continue
+		}
+		types = append(types, NodeType{
+			Symbol:    s,
+			Name:      libc.GoString(C.Xts_language_symbol_name(tls, l.ptr, s)),
+			Named:     m.Named != 0,
+			Supertype: m.Supertype != 0,
+		})
+	}
+	return types
+}
+
+// Field is a field of the language, which names a child of a node.
+type Field struct {
+	ID   C.TSFieldId
+	Name string
+}
+
+// Fields returns the fields of the language, ordered by ID.
+func (l *Language) Fields() []Field {
+	tls := tlspool.Get()
+	defer tlspool.Put(tls)
+	count := C.Xts_language_field_count(tls, l.ptr)
+	fields := make([]Field, 0, count)
+	for id := uint32(1); id <= count; id++ {
+		fields = append(fields, Field{
+			ID:   C.TSFieldId(id),
+			Name: libc.GoString(C.Xts_language_field_name_for_id(tls, l.ptr, C.TSFieldId(id))),
+		})
+	}
+	return fields
+}
+
 type SymbolType int
 
 var symbolTypeNames = []string{
@@ -55,5 +186,8 @@ var symbolTypeNames = []string{
 }
 
 func (t SymbolType) String() string {
+	if t < 0 || int(t) >= len(symbolTypeNames) {
+		return fmt.Sprintf("SymbolType(%d)", int(t))
+	}
 	return symbolTypeNames[t]
 }
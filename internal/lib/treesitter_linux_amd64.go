@@ -19255,8 +19255,8 @@ func Xts_subtree__print_dot_graph(tls *libc.TLS, self uintptr, start_offset Uint
 		libc.VaList(bp+8, start_offset, end_offset,
 			int32(ts_subtree_parse_state(tls, *(*Subtree)(unsafe.Pointer(self)))),
 			ts_subtree_error_cost(tls, *(*Subtree)(unsafe.Pointer(self))),
-			ts_subtree_has_changes(tls, *(*Subtree)(unsafe.Pointer(self))),
-			ts_subtree_depends_on_column(tls, *(*Subtree)(unsafe.Pointer(self))),
+			uint32(ts_subtree_has_changes(tls, *(*Subtree)(unsafe.Pointer(self)))),
+			uint32(ts_subtree_depends_on_column(tls, *(*Subtree)(unsafe.Pointer(self)))),
 			ts_subtree_repeat_depth(tls, *(*Subtree)(unsafe.Pointer(self))),
 			ts_subtree_lookahead_bytes(tls, *(*Subtree)(unsafe.Pointer(self)))))
 
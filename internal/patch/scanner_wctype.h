@@ -0,0 +1,37 @@
+// Wide character classification for the external scanners in
+// internal/*/patch.
+//
+// modernc.org/libc has few of the wide character classification functions,
+// and their wint_t parameter differs between targets, which would make the
+// translation target dependent. Classify characters here instead.
+//
+// This is an approximation. ASCII is classified exactly, as is Unicode white
+// space, but every other non-ASCII character counts as a letter, including
+// punctuation like « or — and symbols like €. Such characters only matter to
+// the scanners right after a keyword or identifier, which is rarely valid
+// source anyway.
+
+#ifndef SCANNER_WCTYPE_H_
+#define SCANNER_WCTYPE_H_
+
+#include <stdbool.h>
+#include <stdint.h>
+
+static inline bool scanner_iswspace(int32_t c) {
+    switch (c) {
+        case '\t': case '\n': case '\v': case '\f': case '\r': case ' ':
+        case 0x85: case 0xa0: case 0x1680: case 0x2028: case 0x2029:
+        case 0x202f: case 0x205f: case 0x3000:
+            return true;
+    }
+    return c >= 0x2000 && c <= 0x200a;
+}
+
+#define iswspace(c) scanner_iswspace(c)
+#define iswdigit(c) ((c) >= '0' && (c) <= '9')
+#define iswxdigit(c) (iswdigit(c) || ((c) >= 'a' && (c) <= 'f') || ((c) >= 'A' && (c) <= 'F'))
+#define iswalpha(c) \
+    (((c) >= 'a' && (c) <= 'z') || ((c) >= 'A' && (c) <= 'Z') || ((c) > 0x7f && !iswspace(c)))
+#define iswalnum(c) (iswalpha(c) || iswdigit(c))
+
+#endif  // SCANNER_WCTYPE_H_
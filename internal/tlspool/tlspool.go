@@ -0,0 +1,34 @@
+// Package tlspool pools the libc thread-local storage used for calls into C.
+package tlspool
+
+import (
+	"runtime"
+	"sync"
+
+	"modernc.org/libc"
+)
+
+// pool holds the libc thread-local storage used for calls into C
+// that may happen on several goroutines at once, like reading a tree.
+// sync.Pool keeps mostly per-P free lists, so concurrent calls don't contend on a lock.
+var pool = sync.Pool{
+	New: func() interface{} {
+		tls := libc.NewTLS()
+		// The pool drops idle entries during garbage collection,
+		// and a TLS holds C memory that only Close releases.
+		runtime.SetFinalizer(tls, (*libc.TLS).Close)
+		return tls
+	},
+}
+
+// Get returns thread-local storage from the pool, or a new one if the pool is empty.
+// The caller must not use it on several goroutines at once,
+// and should return it with Put when done.
+func Get() *libc.TLS {
+	return pool.Get().(*libc.TLS)
+}
+
+// Put returns thread-local storage to the pool.
+func Put(tls *libc.TLS) {
+	pool.Put(tls)
+}
@@ -0,0 +1,13 @@
+// Code generated by 'ccgo -pkgname=toml -export-defines  -export-enums  -export-externs X -export-structs S -export-fields  -export-typedefs  -trace-translation-units -o internal/toml/toml_linux_arm.go -I ./internal/lib -I upstream/tree-sitter/lib/include upstream/tree-sitter-toml/src/parser.c upstream/tree-sitter-toml/src/scanner.c', DO NOT EDIT.
+
+package toml
+
+var CAPI = map[string]struct{}{
+	"tree_sitter_toml":                                            {},
+	"tree_sitter_toml_external_scanner_create":                    {},
+	"tree_sitter_toml_external_scanner_deserialize":               {},
+	"tree_sitter_toml_external_scanner_destroy":                   {},
+	"tree_sitter_toml_external_scanner_scan":                      {},
+	"tree_sitter_toml_external_scanner_scan_multiline_string_end": {},
+	"tree_sitter_toml_external_scanner_serialize":                 {},
+}
@@ -0,0 +1,10790 @@
+// Code generated by 'ccgo -pkgname=toml -export-defines "" -export-enums "" -export-externs X -export-structs S -export-fields "" -export-typedefs "" -trace-translation-units -o internal/toml/toml_darwin_amd64.go -I ./internal/lib -I upstream/tree-sitter/lib/include upstream/tree-sitter-toml/src/parser.c upstream/tree-sitter-toml/src/scanner.c', DO NOT EDIT.
+
+package toml
+
+import (
+	"math"
+	"reflect"
+	"sync/atomic"
+	"unsafe"
+
+	"modernc.org/libc"
+	"modernc.org/libc/sys/types"
+)
+
+var _ = math.Pi
+var _ reflect.Kind
+var _ atomic.Value
+var _ unsafe.Pointer
+var _ *libc.TLS
+var _ types.Size_t
+
+const (
+	ALIAS_COUNT                           = 0                    // parser.c:12:1:
+	BIG_ENDIAN                            = 4321                 // endian.h:28:1:
+	BYTE_ORDER                            = 1234                 // endian.h:30:1:
+	EXIT_FAILURE                          = 1                    // stdlib.h:92:1:
+	EXIT_SUCCESS                          = 0                    // stdlib.h:93:1:
+	EXTERNAL_TOKEN_COUNT                  = 5                    // parser.c:14:1:
+	FD_SETSIZE                            = 1024                 // select.h:73:1:
+	FIELD_COUNT                           = 0                    // parser.c:15:1:
+	INT16_MAX                             = 32767                // stdint.h:122:1:
+	INT16_MIN                             = -32768               // stdint.h:117:1:
+	INT32_MAX                             = 2147483647           // stdint.h:123:1:
+	INT32_MIN                             = -2147483648          // stdint.h:118:1:
+	INT64_MAX                             = 9223372036854775807  // stdint.h:124:1:
+	INT64_MIN                             = -9223372036854775808 // stdint.h:119:1:
+	INT8_MAX                              = 127                  // stdint.h:121:1:
+	INT8_MIN                              = -128                 // stdint.h:116:1:
+	INTMAX_MAX                            = 9223372036854775807  // stdint.h:199:1:
+	INTMAX_MIN                            = -9223372036854775808 // stdint.h:197:1:
+	INTPTR_MAX                            = 9223372036854775807  // stdint.h:187:1:
+	INTPTR_MIN                            = -9223372036854775808 // stdint.h:186:1:
+	INT_FAST16_MAX                        = 9223372036854775807  // stdint.h:164:1:
+	INT_FAST16_MIN                        = -9223372036854775808 // stdint.h:154:1:
+	INT_FAST32_MAX                        = 9223372036854775807  // stdint.h:165:1:
+	INT_FAST32_MIN                        = -9223372036854775808 // stdint.h:155:1:
+	INT_FAST64_MAX                        = 9223372036854775807  // stdint.h:170:1:
+	INT_FAST64_MIN                        = -9223372036854775808 // stdint.h:160:1:
+	INT_FAST8_MAX                         = 127                  // stdint.h:162:1:
+	INT_FAST8_MIN                         = -128                 // stdint.h:152:1:
+	INT_LEAST16_MAX                       = 32767                // stdint.h:140:1:
+	INT_LEAST16_MIN                       = -32768               // stdint.h:135:1:
+	INT_LEAST32_MAX                       = 2147483647           // stdint.h:141:1:
+	INT_LEAST32_MIN                       = -2147483648          // stdint.h:136:1:
+	INT_LEAST64_MAX                       = 9223372036854775807  // stdint.h:142:1:
+	INT_LEAST64_MIN                       = -9223372036854775808 // stdint.h:137:1:
+	INT_LEAST8_MAX                        = 127                  // stdint.h:139:1:
+	INT_LEAST8_MIN                        = -128                 // stdint.h:134:1:
+	LANGUAGE_VERSION                      = 13                   // parser.c:8:1:
+	LARGE_STATE_COUNT                     = 2                    // parser.c:10:1:
+	LITTLE_ENDIAN                         = 1234                 // endian.h:27:1:
+	MAX_ALIAS_SEQUENCE_LENGTH             = 8                    // parser.c:16:1:
+	PDP_ENDIAN                            = 3412                 // endian.h:29:1:
+	PRODUCTION_ID_COUNT                   = 2                    // parser.c:17:1:
+	PTRDIFF_MAX                           = 9223372036854775807  // stdint.h:210:1:
+	PTRDIFF_MIN                           = -9223372036854775808 // stdint.h:209:1:
+	RAND_MAX                              = 2147483647           // stdlib.h:87:1:
+	SIG_ATOMIC_MAX                        = 2147483647           // stdint.h:223:1:
+	SIG_ATOMIC_MIN                        = -2147483648          // stdint.h:222:1:
+	SIZE_MAX                              = 18446744073709551615 // stdint.h:227:1:
+	STATE_COUNT                           = 152                  // parser.c:9:1:
+	SYMBOL_COUNT                          = 66                   // parser.c:11:1:
+	TOKEN_COUNT                           = 40                   // parser.c:13:1:
+	TREE_SITTER_PARSER_H_                 = 0                    // parser.h:2:1:
+	TREE_SITTER_SERIALIZATION_BUFFER_SIZE = 1024                 // parser.h:14:1:
+	UINT16_MAX                            = 65535                // stdint.h:128:1:
+	UINT32_MAX                            = 4294967295           // stdint.h:129:1:
+	UINT64_MAX                            = 18446744073709551615 // stdint.h:130:1:
+	UINT8_MAX                             = 255                  // stdint.h:127:1:
+	UINTMAX_MAX                           = 18446744073709551615 // stdint.h:202:1:
+	UINTPTR_MAX                           = 18446744073709551615 // stdint.h:188:1:
+	UINT_FAST16_MAX                       = 18446744073709551615 // stdint.h:175:1:
+	UINT_FAST32_MAX                       = 18446744073709551615 // stdint.h:176:1:
+	UINT_FAST64_MAX                       = 18446744073709551615 // stdint.h:181:1:
+	UINT_FAST8_MAX                        = 255                  // stdint.h:173:1:
+	UINT_LEAST16_MAX                      = 65535                // stdint.h:146:1:
+	UINT_LEAST32_MAX                      = 4294967295           // stdint.h:147:1:
+	UINT_LEAST64_MAX                      = 18446744073709551615 // stdint.h:148:1:
+	UINT_LEAST8_MAX                       = 255                  // stdint.h:145:1:
+	WCHAR_MAX                             = 2147483647           // stdint.h:240:1:
+	WCHAR_MIN                             = -2147483648          // stdint.h:239:1:
+	WCONTINUED                            = 8                    // waitflags.h:32:1:
+	WEXITED                               = 4                    // waitflags.h:31:1:
+	WINT_MAX                              = 4294967295           // stdint.h:245:1:
+	WINT_MIN                              = 0                    // stdint.h:244:1:
+	WNOHANG                               = 1                    // waitflags.h:25:1:
+	WNOWAIT                               = 0x01000000           // waitflags.h:33:1:
+	WSTOPPED                              = 2                    // waitflags.h:30:1:
+	WUNTRACED                             = 2                    // waitflags.h:26:1:
+	X_ALLOCA_H                            = 1                    // alloca.h:19:1:
+	X_ATFILE_SOURCE                       = 1                    // features.h:351:1:
+	X_BITS_ATOMIC_WIDE_COUNTER_H          = 0                    // atomic_wide_counter.h:20:1:
+	X_BITS_BYTESWAP_H                     = 1                    // byteswap.h:24:1:
+	X_BITS_ENDIANNESS_H                   = 1                    // endianness.h:2:1:
+	X_BITS_ENDIAN_H                       = 1                    // endian.h:20:1:
+	X_BITS_FLOATN_COMMON_H                = 0                    // floatn-common.h:21:1:
+	X_BITS_FLOATN_H                       = 0                    // floatn.h:20:1:
+	X_BITS_PTHREADTYPES_ARCH_H            = 1                    // pthreadtypes-arch.h:19:1:
+	X_BITS_PTHREADTYPES_COMMON_H          = 1                    // pthreadtypes.h:20:1:
+	X_BITS_STDINT_INTN_H                  = 1                    // stdint-intn.h:20:1:
+	X_BITS_STDINT_UINTN_H                 = 1                    // stdint-uintn.h:20:1:
+	X_BITS_TIME64_H                       = 1                    // time64.h:24:1:
+	X_BITS_TYPESIZES_H                    = 1                    // typesizes.h:24:1:
+	X_BITS_TYPES_H                        = 1                    // types.h:24:1:
+	X_BITS_UINTN_IDENTITY_H               = 1                    // uintn-identity.h:24:1:
+	X_BITS_WCHAR_H                        = 1                    // wchar.h:20:1:
+	X_BSD_SIZE_T_                         = 0                    // stddef.h:193:1:
+	X_BSD_SIZE_T_DEFINED_                 = 0                    // stddef.h:196:1:
+	X_DEFAULT_SOURCE                      = 1                    // features.h:236:1:
+	X_ENDIAN_H                            = 1                    // endian.h:19:1:
+	X_FEATURES_H                          = 1                    // features.h:19:1:
+	X_FILE_OFFSET_BITS                    = 64                   // <builtin>:25:1:
+	X_GCC_SIZE_T                          = 0                    // stddef.h:200:1:
+	X_GCC_WCHAR_T                         = 0                    // stddef.h:280:1:
+	X_GCC_WRAP_STDINT_H                   = 0                    // stdint.h:13:1:
+	X_LP64                                = 1                    // <predefined>:312:1:
+	X_POSIX_C_SOURCE                      = 200809               // features.h:290:1:
+	X_POSIX_SOURCE                        = 1                    // features.h:288:1:
+	X_RWLOCK_INTERNAL_H                   = 0                    // struct_rwlock.h:21:1:
+	X_SIZET_                              = 0                    // stddef.h:201:1:
+	X_SIZE_T                              = 0                    // stddef.h:187:1:
+	X_SIZE_T_                             = 0                    // stddef.h:192:1:
+	X_SIZE_T_DECLARED                     = 0                    // stddef.h:197:1:
+	X_SIZE_T_DEFINED                      = 0                    // stddef.h:195:1:
+	X_SIZE_T_DEFINED_                     = 0                    // stddef.h:194:1:
+	X_STDBOOL_H                           = 0                    // stdbool.h:29:1:
+	X_STDC_PREDEF_H                       = 1                    // <predefined>:174:1:
+	X_STDINT_H                            = 1                    // stdint.h:23:1:
+	X_STDLIB_H                            = 1                    // stdlib.h:36:1:
+	X_STRUCT_TIMESPEC                     = 1                    // struct_timespec.h:3:1:
+	X_SYS_CDEFS_H                         = 1                    // cdefs.h:20:1:
+	X_SYS_SELECT_H                        = 1                    // select.h:22:1:
+	X_SYS_SIZE_T_H                        = 0                    // stddef.h:188:1:
+	X_SYS_TYPES_H                         = 1                    // types.h:23:1:
+	X_THREAD_MUTEX_INTERNAL_H             = 1                    // struct_mutex.h:20:1:
+	X_THREAD_SHARED_TYPES_H               = 1                    // thread-shared-types.h:20:1:
+	X_T_SIZE                              = 0                    // stddef.h:190:1:
+	X_T_SIZE_                             = 0                    // stddef.h:189:1:
+	X_T_WCHAR                             = 0                    // stddef.h:271:1:
+	X_T_WCHAR_                            = 0                    // stddef.h:270:1:
+	X_WCHAR_T                             = 0                    // stddef.h:269:1:
+	X_WCHAR_T_                            = 0                    // stddef.h:273:1:
+	X_WCHAR_T_DECLARED                    = 0                    // stddef.h:281:1:
+	X_WCHAR_T_DEFINED                     = 0                    // stddef.h:276:1:
+	X_WCHAR_T_DEFINED_                    = 0                    // stddef.h:275:1:
+	X_WCHAR_T_H                           = 0                    // stddef.h:277:1:
+	False                                 = 0                    // stdbool.h:39:1:
+	Linux                                 = 1                    // <predefined>:255:1:
+	True                                  = 1                    // stdbool.h:38:1:
+	Ts_builtin_sym_end                    = 0                    // parser.h:13:1:
+	Unix                                  = 1                    // <predefined>:191:1:
+)
+
+const ( /* parser.c:1657:1: */
+	Ts_external_token__line_ending_or_eof               = 0
+	Ts_external_token__multiline_basic_string_content   = 1
+	Ts_external_token__multiline_basic_string_end       = 2
+	Ts_external_token__multiline_literal_string_content = 3
+	Ts_external_token__multiline_literal_string_end     = 4
+)
+
+//  Lexer Macros
+
+//  Parse Table Macros
+
+const ( /* parser.c:19:1: */
+	Aux_sym_document_token1                   = 1
+	Sym_comment                               = 2
+	Anon_sym_LBRACK                           = 3
+	Anon_sym_RBRACK                           = 4
+	Anon_sym_LBRACK_LBRACK                    = 5
+	Anon_sym_RBRACK_RBRACK                    = 6
+	Anon_sym_EQ                               = 7
+	Anon_sym_DOT                              = 8
+	Sym_bare_key                              = 9
+	Anon_sym_DQUOTE                           = 10
+	Aux_sym__basic_string_token1              = 11
+	Anon_sym_DQUOTE2                          = 12
+	Anon_sym_DQUOTE_DQUOTE_DQUOTE             = 13
+	Aux_sym__multiline_basic_string_token1    = 14
+	Sym_escape_sequence                       = 15
+	Sym__escape_line_ending                   = 16
+	Anon_sym_SQUOTE                           = 17
+	Aux_sym__literal_string_token1            = 18
+	Anon_sym_SQUOTE2                          = 19
+	Anon_sym_SQUOTE_SQUOTE_SQUOTE             = 20
+	Aux_sym_integer_token1                    = 21
+	Aux_sym_integer_token2                    = 22
+	Aux_sym_integer_token3                    = 23
+	Aux_sym_integer_token4                    = 24
+	Aux_sym_float_token1                      = 25
+	Aux_sym_float_token2                      = 26
+	Sym_boolean                               = 27
+	Sym_offset_date_time                      = 28
+	Sym_local_date_time                       = 29
+	Sym_local_date                            = 30
+	Sym_local_time                            = 31
+	Anon_sym_COMMA                            = 32
+	Anon_sym_LBRACE                           = 33
+	Anon_sym_RBRACE                           = 34
+	Sym__line_ending_or_eof                   = 35
+	Sym__multiline_basic_string_content       = 36
+	Sym__multiline_basic_string_end           = 37
+	Sym__multiline_literal_string_content     = 38
+	Sym__multiline_literal_string_end         = 39
+	Sym_document                              = 40
+	Sym_table                                 = 41
+	Sym_table_array_element                   = 42
+	Sym_pair                                  = 43
+	Sym__inline_pair                          = 44
+	Sym__key                                  = 45
+	Sym_dotted_key                            = 46
+	Sym_quoted_key                            = 47
+	Sym__inline_value                         = 48
+	Sym_string                                = 49
+	Sym__basic_string                         = 50
+	Sym__multiline_basic_string               = 51
+	Sym__literal_string                       = 52
+	Sym__multiline_literal_string             = 53
+	Sym_integer                               = 54
+	Sym_float                                 = 55
+	Sym_array                                 = 56
+	Sym_inline_table                          = 57
+	Aux_sym_document_repeat1                  = 58
+	Aux_sym_document_repeat2                  = 59
+	Aux_sym__basic_string_repeat1             = 60
+	Aux_sym__multiline_basic_string_repeat1   = 61
+	Aux_sym__multiline_literal_string_repeat1 = 62
+	Aux_sym_array_repeat1                     = 63
+	Aux_sym_array_repeat2                     = 64
+	Aux_sym_inline_table_repeat1              = 65
+)
+
+//  Lexer Macros
+
+//  Parse Table Macros
+
+const ( /* scanner.c:8:1: */
+	LINE_ENDING_OR_EOF               = 0
+	MULTILINE_BASIC_STRING_CONTENT   = 1
+	MULTILINE_BASIC_STRING_END       = 2
+	MULTILINE_LITERAL_STRING_CONTENT = 3
+	MULTILINE_LITERAL_STRING_END     = 4
+)
+
+const ( /* parser.h:53:1: */
+	TSParseActionTypeShift   = 0
+	TSParseActionTypeReduce  = 1
+	TSParseActionTypeAccept  = 2
+	TSParseActionTypeRecover = 3
+)
+
+type Ptrdiff_t = int64 /* <builtin>:3:26 */
+
+type Size_t = uint64 /* <builtin>:9:23 */
+
+type Wchar_t = int32 /* <builtin>:15:24 */
+
+type X__int128_t = struct {
+	Lo int64
+	Hi int64
+} /* <builtin>:21:43 */ // must match modernc.org/mathutil.Int128
+type X__uint128_t = struct {
+	Lo uint64
+	Hi uint64
+} /* <builtin>:22:44 */ // must match modernc.org/mathutil.Int128
+
+type X__builtin_va_list = uintptr /* <builtin>:46:14 */
+type X__float128 = float64        /* <builtin>:47:21 */
+
+// Copyright (C) 1998-2022 Free Software Foundation, Inc.
+//
+// This file is part of GCC.
+//
+// GCC is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 3, or (at your option)
+// any later version.
+//
+// GCC is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// Under Section 7 of GPL version 3, you are granted additional
+// permissions described in the GCC Runtime Library Exception, version
+// 3.1, as published by the Free Software Foundation.
+//
+// You should have received a copy of the GNU General Public License and
+// a copy of the GCC Runtime Library Exception along with this program;
+// see the files COPYING3 and COPYING.RUNTIME respectively.  If not, see
+// <http://www.gnu.org/licenses/>.
+
+// ISO C Standard:  7.16  Boolean type and values  <stdbool.h>
+
+// Signal that all the definitions are present.
+
+// Copyright (C) 1997-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+//	ISO C99: 7.18 Integer types <stdint.h>
+
+// Handle feature test macros at the start of a header.
+//    Copyright (C) 2016-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// This header is internal to glibc and should not be included outside
+//    of glibc headers.  Headers including it must define
+//    __GLIBC_INTERNAL_STARTING_HEADER_IMPLEMENTATION first.  This header
+//    cannot have multiple include guards because ISO C feature test
+//    macros depend on the definition of the macro when an affected
+//    header is included, not when the first system header is
+//    included.
+
+// Copyright (C) 1991-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// These are defined by the user (or the compiler)
+//    to specify the desired environment:
+//
+//    __STRICT_ANSI__	ISO Standard C.
+//    _ISOC99_SOURCE	Extensions to ISO C89 from ISO C99.
+//    _ISOC11_SOURCE	Extensions to ISO C99 from ISO C11.
+//    _ISOC2X_SOURCE	Extensions to ISO C99 from ISO C2X.
+//    __STDC_WANT_LIB_EXT2__
+// 			Extensions to ISO C99 from TR 27431-2:2010.
+//    __STDC_WANT_IEC_60559_BFP_EXT__
+// 			Extensions to ISO C11 from TS 18661-1:2014.
+//    __STDC_WANT_IEC_60559_FUNCS_EXT__
+// 			Extensions to ISO C11 from TS 18661-4:2015.
+//    __STDC_WANT_IEC_60559_TYPES_EXT__
+// 			Extensions to ISO C11 from TS 18661-3:2015.
+//    __STDC_WANT_IEC_60559_EXT__
+// 			ISO C2X interfaces defined only in Annex F.
+//
+//    _POSIX_SOURCE	IEEE Std 1003.1.
+//    _POSIX_C_SOURCE	If ==1, like _POSIX_SOURCE; if >=2 add IEEE Std 1003.2;
+// 			if >=199309L, add IEEE Std 1003.1b-1993;
+// 			if >=199506L, add IEEE Std 1003.1c-1995;
+// 			if >=200112L, all of IEEE 1003.1-2004
+// 			if >=200809L, all of IEEE 1003.1-2008
+//    _XOPEN_SOURCE	Includes POSIX and XPG things.  Set to 500 if
+// 			Single Unix conformance is wanted, to 600 for the
+// 			sixth revision, to 700 for the seventh revision.
+//    _XOPEN_SOURCE_EXTENDED XPG things and X/Open Unix extensions.
+//    _LARGEFILE_SOURCE	Some more functions for correct standard I/O.
+//    _LARGEFILE64_SOURCE	Additional functionality from LFS for large files.
+//    _FILE_OFFSET_BITS=N	Select default filesystem interface.
+//    _ATFILE_SOURCE	Additional *at interfaces.
+//    _DYNAMIC_STACK_SIZE_SOURCE Select correct (but non compile-time constant)
+// 			MINSIGSTKSZ, SIGSTKSZ and PTHREAD_STACK_MIN.
+//    _GNU_SOURCE		All of the above, plus GNU extensions.
+//    _DEFAULT_SOURCE	The default set of features (taking precedence over
+// 			__STRICT_ANSI__).
+//
+//    _FORTIFY_SOURCE	Add security hardening to many library functions.
+// 			Set to 1 or 2; 2 performs stricter checks than 1.
+//
+//    _REENTRANT, _THREAD_SAFE
+// 			Obsolete; equivalent to _POSIX_C_SOURCE=199506L.
+//
+//    The `-ansi' switch to the GNU C compiler, and standards conformance
+//    options such as `-std=c99', define __STRICT_ANSI__.  If none of
+//    these are defined, or if _DEFAULT_SOURCE is defined, the default is
+//    to have _POSIX_SOURCE set to one and _POSIX_C_SOURCE set to
+//    200809L, as well as enabling miscellaneous functions from BSD and
+//    SVID.  If more than one of these are defined, they accumulate.  For
+//    example __STRICT_ANSI__, _POSIX_SOURCE and _POSIX_C_SOURCE together
+//    give you ISO C, 1003.1, and 1003.2, but nothing else.
+//
+//    These are defined by this file and are used by the
+//    header files to decide what to declare or define:
+//
+//    __GLIBC_USE (F)	Define things from feature set F.  This is defined
+// 			to 1 or 0; the subsequent macros are either defined
+// 			or undefined, and those tests should be moved to
+// 			__GLIBC_USE.
+//    __USE_ISOC11		Define ISO C11 things.
+//    __USE_ISOC99		Define ISO C99 things.
+//    __USE_ISOC95		Define ISO C90 AMD1 (C95) things.
+//    __USE_ISOCXX11	Define ISO C++11 things.
+//    __USE_POSIX		Define IEEE Std 1003.1 things.
+//    __USE_POSIX2		Define IEEE Std 1003.2 things.
+//    __USE_POSIX199309	Define IEEE Std 1003.1, and .1b things.
+//    __USE_POSIX199506	Define IEEE Std 1003.1, .1b, .1c and .1i things.
+//    __USE_XOPEN		Define XPG things.
+//    __USE_XOPEN_EXTENDED	Define X/Open Unix things.
+//    __USE_UNIX98		Define Single Unix V2 things.
+//    __USE_XOPEN2K        Define XPG6 things.
+//    __USE_XOPEN2KXSI     Define XPG6 XSI things.
+//    __USE_XOPEN2K8       Define XPG7 things.
+//    __USE_XOPEN2K8XSI    Define XPG7 XSI things.
+//    __USE_LARGEFILE	Define correct standard I/O things.
+//    __USE_LARGEFILE64	Define LFS things with separate names.
+//    __USE_FILE_OFFSET64	Define 64bit interface as default.
+//    __USE_MISC		Define things from 4.3BSD or System V Unix.
+//    __USE_ATFILE		Define *at interfaces and AT_* constants for them.
+//    __USE_DYNAMIC_STACK_SIZE Define correct (but non compile-time constant)
+// 			MINSIGSTKSZ, SIGSTKSZ and PTHREAD_STACK_MIN.
+//    __USE_GNU		Define GNU extensions.
+//    __USE_FORTIFY_LEVEL	Additional security measures used, according to level.
+//
+//    The macros `__GNU_LIBRARY__', `__GLIBC__', and `__GLIBC_MINOR__' are
+//    defined by this file unconditionally.  `__GNU_LIBRARY__' is provided
+//    only for compatibility.  All new code should use the other symbols
+//    to test for features.
+//
+//    All macros listed above as possibly being defined by this file are
+//    explicitly undefined if they are not explicitly defined.
+//    Feature-test macros that are not defined by the user or compiler
+//    but are implied by the other feature-test macros defined (or by the
+//    lack of any definitions) are defined by the file.
+//
+//    ISO C feature test macros depend on the definition of the macro
+//    when an affected header is included, not when the first system
+//    header is included, and so they are handled in
+//    <bits/libc-header-start.h>, which does not have a multiple include
+//    guard.  Feature test macros that can be handled from the first
+//    system header included are handled here.
+
+// Undefine everything, so we get a clean slate.
+
+// Suppress kernel-name space pollution unless user expressedly asks
+//    for it.
+
+// Convenience macro to test the version of gcc.
+//    Use like this:
+//    #if __GNUC_PREREQ (2,8)
+//    ... code requiring gcc 2.8 or later ...
+//    #endif
+//    Note: only works for GCC 2.0 and later, because __GNUC_MINOR__ was
+//    added in 2.0.
+
+// Similarly for clang.  Features added to GCC after version 4.2 may
+//    or may not also be available in clang, and clang's definitions of
+//    __GNUC(_MINOR)__ are fixed at 4 and 2 respectively.  Not all such
+//    features can be queried via __has_extension/__has_feature.
+
+// Whether to use feature set F.
+
+// _BSD_SOURCE and _SVID_SOURCE are deprecated aliases for
+//    _DEFAULT_SOURCE.  If _DEFAULT_SOURCE is present we do not
+//    issue a warning; the expectation is that the source is being
+//    transitioned to use the new macro.
+
+// If _GNU_SOURCE was defined by the user, turn on all the other features.
+
+// If nothing (other than _GNU_SOURCE and _DEFAULT_SOURCE) is defined,
+//    define _DEFAULT_SOURCE.
+
+// This is to enable the ISO C2X extension.
+
+// This is to enable the ISO C11 extension.
+
+// This is to enable the ISO C99 extension.
+
+// This is to enable the ISO C90 Amendment 1:1995 extension.
+
+// If none of the ANSI/POSIX macros are defined, or if _DEFAULT_SOURCE
+//    is defined, use POSIX.1-2008 (or another version depending on
+//    _XOPEN_SOURCE).
+
+// Some C libraries once required _REENTRANT and/or _THREAD_SAFE to be
+//    defined in all multithreaded code.  GNU libc has not required this
+//    for many years.  We now treat them as compatibility synonyms for
+//    _POSIX_C_SOURCE=199506L, which is the earliest level of POSIX with
+//    comprehensive support for multithreaded code.  Using them never
+//    lowers the selected level of POSIX conformance, only raises it.
+
+// Features part to handle 64-bit time_t support.
+//    Copyright (C) 2021-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// We need to know the word size in order to check the time size.
+// Determine the wordsize from the preprocessor defines.
+
+// Both x86-64 and x32 use the 64-bit system call interface.
+// Bit size of the time_t type at glibc build time, x86-64 and x32 case.
+//    Copyright (C) 2018-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// Determine the wordsize from the preprocessor defines.
+
+// Both x86-64 and x32 use the 64-bit system call interface.
+
+// For others, time size is word size.
+
+// The function 'gets' existed in C89, but is impossible to use
+//    safely.  It has been removed from ISO C11 and ISO C++14.  Note: for
+//    compatibility with various implementations of <cstdio>, this test
+//    must consider only the value of __cplusplus when compiling C++.
+
+// GNU formerly extended the scanf functions with modified format
+//    specifiers %as, %aS, and %a[...] that allocate a buffer for the
+//    input using malloc.  This extension conflicts with ISO C99, which
+//    defines %a as a standalone format specifier that reads a floating-
+//    point number; moreover, POSIX.1-2008 provides the same feature
+//    using the modifier letter 'm' instead (%ms, %mS, %m[...]).
+//
+//    We now follow C99 unless GNU extensions are active and the compiler
+//    is specifically in C89 or C++98 mode (strict or not).  For
+//    instance, with GCC, -std=gnu11 will have C99-compliant scanf with
+//    or without -D_GNU_SOURCE, but -std=c89 -D_GNU_SOURCE will have the
+//    old extension.
+
+// Get definitions of __STDC_* predefined macros, if the compiler has
+//    not preincluded this header automatically.
+// Copyright (C) 1991-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// This macro indicates that the installed library is the GNU C Library.
+//    For historic reasons the value now is 6 and this will stay from now
+//    on.  The use of this variable is deprecated.  Use __GLIBC__ and
+//    __GLIBC_MINOR__ now (see below) when you want to test for a specific
+//    GNU C library version and use the values in <gnu/lib-names.h> to get
+//    the sonames of the shared libraries.
+
+// Major and minor version number of the GNU C library package.  Use
+//    these macros to test for features in specific releases.
+
+// This is here only because every header file already includes this one.
+// Copyright (C) 1992-2022 Free Software Foundation, Inc.
+//    Copyright The GNU Toolchain Authors.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// We are almost always included from features.h.
+
+// The GNU libc does not support any K&R compilers or the traditional mode
+//    of ISO C compilers anymore.  Check for some of the combinations not
+//    supported anymore.
+
+// Some user header file might have defined this before.
+
+// Compilers that lack __has_attribute may object to
+//        #if defined __has_attribute && __has_attribute (...)
+//    even though they do not need to evaluate the right-hand side of the &&.
+//    Similarly for __has_builtin, etc.
+
+// All functions, except those with callbacks or those that
+//    synchronize memory, are leaf functions.
+
+// GCC can always grok prototypes.  For C++ programs we add throw()
+//    to help it optimize the function calls.  But this only works with
+//    gcc 2.8.x and egcs.  For gcc 3.4 and up we even mark C functions
+//    as non-throwing using a function attribute since programs can use
+//    the -fexceptions options for C code as well.
+
+// These two macros are not used in glibc anymore.  They are kept here
+//    only because some other projects expect the macros to be defined.
+
+// For these things, GCC behaves the ANSI way normally,
+//    and the non-ANSI way under -traditional.
+
+// This is not a typedef so `const __ptr_t' does the right thing.
+
+// C++ needs to know that types and declarations are C, not C++.
+
+// Fortify support.
+
+// Use __builtin_dynamic_object_size at _FORTIFY_SOURCE=3 when available.
+
+// Support for flexible arrays.
+//    Headers that should use flexible arrays only if they're "real"
+//    (e.g. only if they won't affect sizeof()) should test
+//    #if __glibc_c99_flexarr_available.
+
+// __asm__ ("xyz") is used throughout the headers to rename functions
+//    at the assembly language level.  This is wrapped by the __REDIRECT
+//    macro, in order to support compilers that can do this some other
+//    way.  When compilers don't support asm-names at all, we have to do
+//    preprocessor tricks instead (which don't have exactly the right
+//    semantics, but it's the best we can do).
+//
+//    Example:
+//    int __REDIRECT(setpgrp, (__pid_t pid, __pid_t pgrp), setpgid);
+
+//
+// #elif __SOME_OTHER_COMPILER__
+//
+// # define __REDIRECT(name, proto, alias) name proto; 	_Pragma("let " #name " = " #alias)
+
+// GCC and clang have various useful declarations that can be made with
+//    the '__attribute__' syntax.  All of the ways we use this do fine if
+//    they are omitted for compilers that don't understand it.
+
+// At some point during the gcc 2.96 development the `malloc' attribute
+//    for functions was introduced.  We don't want to use it unconditionally
+//    (although this would be possible) since it generates warnings.
+
+// Tell the compiler which arguments to an allocation function
+//    indicate the size of the allocation.
+
+// Tell the compiler which argument to an allocation function
+//    indicates the alignment of the allocation.
+
+// At some point during the gcc 2.96 development the `pure' attribute
+//    for functions was introduced.  We don't want to use it unconditionally
+//    (although this would be possible) since it generates warnings.
+
+// This declaration tells the compiler that the value is constant.
+
+// At some point during the gcc 3.1 development the `used' attribute
+//    for functions was introduced.  We don't want to use it unconditionally
+//    (although this would be possible) since it generates warnings.
+
+// Since version 3.2, gcc allows marking deprecated functions.
+
+// Since version 4.5, gcc also allows one to specify the message printed
+//    when a deprecated function is used.  clang claims to be gcc 4.2, but
+//    may also support this feature.
+
+// At some point during the gcc 2.8 development the `format_arg' attribute
+//    for functions was introduced.  We don't want to use it unconditionally
+//    (although this would be possible) since it generates warnings.
+//    If several `format_arg' attributes are given for the same function, in
+//    gcc-3.0 and older, all but the last one are ignored.  In newer gccs,
+//    all designated arguments are considered.
+
+// At some point during the gcc 2.97 development the `strfmon' format
+//    attribute for functions was introduced.  We don't want to use it
+//    unconditionally (although this would be possible) since it
+//    generates warnings.
+
+// The nonnull function attribute marks pointer parameters that
+//    must not be NULL.  This has the name __nonnull in glibc,
+//    and __attribute_nonnull__ in files shared with Gnulib to avoid
+//    collision with a different __nonnull in DragonFlyBSD 5.9.
+
+// The returns_nonnull function attribute marks the return type of the function
+//    as always being non-null.
+
+// If fortification mode, we warn about unused results of certain
+//    function calls which can lead to problems.
+
+// Forces a function to be always inlined.
+// The Linux kernel defines __always_inline in stddef.h (283d7573), and
+//    it conflicts with this definition.  Therefore undefine it first to
+//    allow either header to be included first.
+
+// Associate error messages with the source location of the call site rather
+//    than with the source location inside the function.
+
+// GCC 4.3 and above with -std=c99 or -std=gnu99 implements ISO C99
+//    inline semantics, unless -fgnu89-inline is used.  Using __GNUC_STDC_INLINE__
+//    or __GNUC_GNU_INLINE is not a good enough check for gcc because gcc versions
+//    older than 4.3 may define these macros and still not guarantee GNU inlining
+//    semantics.
+//
+//    clang++ identifies itself as gcc-4.2, but has support for GNU inlining
+//    semantics, that can be checked for by using the __GNUC_STDC_INLINE_ and
+//    __GNUC_GNU_INLINE__ macro definitions.
+
+// GCC 4.3 and above allow passing all anonymous arguments of an
+//    __extern_always_inline function to some other vararg function.
+
+// It is possible to compile containing GCC extensions even if GCC is
+//    run in pedantic mode if the uses are carefully marked using the
+//    `__extension__' keyword.  But this is not generally available before
+//    version 2.8.
+
+// __restrict is known in EGCS 1.2 and above, and in clang.
+//    It works also in C++ mode (outside of arrays), but only when spelled
+//    as '__restrict', not 'restrict'.
+
+// ISO C99 also allows to declare arrays as non-overlapping.  The syntax is
+//      array_name[restrict]
+//    GCC 3.1 and clang support this.
+//    This syntax is not usable in C++ mode.
+
+// Describes a char array whose address can safely be passed as the first
+//    argument to strncpy and strncat, as the char array is not necessarily
+//    a NUL-terminated string.
+
+// Undefine (also defined in libc-symbols.h).
+// Copies attributes from the declaration or type referenced by
+//    the argument.
+
+// Gnulib avoids including these, as they don't work on non-glibc or
+//    older glibc platforms.
+// Determine the wordsize from the preprocessor defines.
+
+// Both x86-64 and x32 use the 64-bit system call interface.
+// Properties of long double type.  ldbl-96 version.
+//    Copyright (C) 2016-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License  published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// long double is distinct from double, so there is nothing to
+//    define here.
+
+// __glibc_macro_warning (MESSAGE) issues warning MESSAGE.  This is
+//    intended for use in preprocessor macros.
+//
+//    Note: MESSAGE must be a _single_ string; concatenation of string
+//    literals is not supported.
+
+// Generic selection (ISO C11) is a C-only feature, available in GCC
+//    since version 4.9.  Previous versions do not provide generic
+//    selection, even though they might set __STDC_VERSION__ to 201112L,
+//    when in -std=c11 mode.  Thus, we must check for !defined __GNUC__
+//    when testing __STDC_VERSION__ for generic selection support.
+//    On the other hand, Clang also defines __GNUC__, so a clang-specific
+//    check is required to enable the use of generic selection.
+
+// Designates a 1-based positional argument ref-index of pointer type
+//    that can be used to access size-index elements of the pointed-to
+//    array according to access mode, or at least one element when
+//    size-index is not provided:
+//      access (access-mode, <ref-index> [, <size-index>])
+// For _FORTIFY_SOURCE == 3 we use __builtin_dynamic_object_size, which may
+//    use the access attribute to get object sizes from function definition
+//    arguments, so we can't use them on functions we fortify.  Drop the object
+//    size hints for such functions.
+
+// Designates dealloc as a function to call to deallocate objects
+//    allocated by the declared function.
+
+// Specify that a function such as setjmp or vfork may return
+//    twice.
+
+// If we don't have __REDIRECT, prototypes will be missing if
+//    __USE_FILE_OFFSET64 but not __USE_LARGEFILE[64].
+
+// Decide whether we can define 'extern inline' functions in headers.
+
+// This is here only because every header file already includes this one.
+//    Get the definitions of all the appropriate `__stub_FUNCTION' symbols.
+//    <gnu/stubs.h> contains `#define __stub_FUNCTION' when FUNCTION is a stub
+//    that will always return failure (and set errno to ENOSYS).
+// This file is automatically generated.
+//    This file selects the right generated file of `__stub_FUNCTION' macros
+//    based on the architecture being compiled for.
+
+// This file is automatically generated.
+//    It defines a symbol `__stub_FUNCTION' for each function
+//    in the C library which is a stub, meaning it will fail
+//    every time called, usually setting errno to ENOSYS.
+
+// ISO/IEC TR 24731-2:2010 defines the __STDC_WANT_LIB_EXT2__
+//    macro.
+
+// ISO/IEC TS 18661-1:2014 defines the __STDC_WANT_IEC_60559_BFP_EXT__
+//    macro.  Most but not all symbols enabled by that macro in TS
+//    18661-1 are enabled unconditionally in C2X.  In C2X, the symbols in
+//    Annex F still require a new feature test macro
+//    __STDC_WANT_IEC_60559_EXT__ instead (C2X does not define
+//    __STDC_WANT_IEC_60559_BFP_EXT__), while a few features from TS
+//    18661-1 are not included in C2X (and thus should depend on
+//    __STDC_WANT_IEC_60559_BFP_EXT__ even when C2X features are
+//    enabled).
+//
+//    __GLIBC_USE (IEC_60559_BFP_EXT) controls those features from TS
+//    18661-1 not included in C2X.
+//
+//    __GLIBC_USE (IEC_60559_BFP_EXT_C2X) controls those features from TS
+//    18661-1 that are also included in C2X (with no feature test macro
+//    required in C2X).
+//
+//    __GLIBC_USE (IEC_60559_EXT) controls those features from TS 18661-1
+//    that are included in C2X but conditional on
+//    __STDC_WANT_IEC_60559_EXT__.  (There are currently no features
+//    conditional on __STDC_WANT_IEC_60559_EXT__ that are not in TS
+//    18661-1.)
+
+// ISO/IEC TS 18661-4:2015 defines the
+//    __STDC_WANT_IEC_60559_FUNCS_EXT__ macro.  Other than the reduction
+//    functions, the symbols from this TS are enabled unconditionally in
+//    C2X.
+
+// ISO/IEC TS 18661-3:2015 defines the
+//    __STDC_WANT_IEC_60559_TYPES_EXT__ macro.
+// bits/types.h -- definitions of __*_t types underlying *_t types.
+//    Copyright (C) 2002-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// Never include this file directly; use <sys/types.h> instead.
+
+// Copyright (C) 1991-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// Determine the wordsize from the preprocessor defines.
+
+// Both x86-64 and x32 use the 64-bit system call interface.
+// Bit size of the time_t type at glibc build time, x86-64 and x32 case.
+//    Copyright (C) 2018-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// Determine the wordsize from the preprocessor defines.
+
+// Both x86-64 and x32 use the 64-bit system call interface.
+
+// For others, time size is word size.
+
+// Convenience types.
+type X__u_char = uint8   /* types.h:31:23 */
+type X__u_short = uint16 /* types.h:32:28 */
+type X__u_int = uint32   /* types.h:33:22 */
+type X__u_long = uint64  /* types.h:34:27 */
+
+// Fixed-size types, underlying types depend on word size and compiler.
+type X__int8_t = int8     /* types.h:37:21 */
+type X__uint8_t = uint8   /* types.h:38:23 */
+type X__int16_t = int16   /* types.h:39:26 */
+type X__uint16_t = uint16 /* types.h:40:28 */
+type X__int32_t = int32   /* types.h:41:20 */
+type X__uint32_t = uint32 /* types.h:42:22 */
+type X__int64_t = int64   /* types.h:44:25 */
+type X__uint64_t = uint64 /* types.h:45:27 */
+
+// Smallest types with at least a given width.
+type X__int_least8_t = X__int8_t     /* types.h:52:18 */
+type X__uint_least8_t = X__uint8_t   /* types.h:53:19 */
+type X__int_least16_t = X__int16_t   /* types.h:54:19 */
+type X__uint_least16_t = X__uint16_t /* types.h:55:20 */
+type X__int_least32_t = X__int32_t   /* types.h:56:19 */
+type X__uint_least32_t = X__uint32_t /* types.h:57:20 */
+type X__int_least64_t = X__int64_t   /* types.h:58:19 */
+type X__uint_least64_t = X__uint64_t /* types.h:59:20 */
+
+// quad_t is also 64 bits.
+type X__quad_t = int64    /* types.h:63:18 */
+type X__u_quad_t = uint64 /* types.h:64:27 */
+
+// Largest integral types.
+type X__intmax_t = int64   /* types.h:72:18 */
+type X__uintmax_t = uint64 /* types.h:73:27 */
+
+// The machine-dependent file <bits/typesizes.h> defines __*_T_TYPE
+//    macros for each of the OS types we define below.  The definitions
+//    of those macros must use the following macros for underlying types.
+//    We define __S<SIZE>_TYPE and __U<SIZE>_TYPE for the signed and unsigned
+//    variants of each of the following integer types on this machine.
+//
+// 	16		-- "natural" 16-bit type (always short)
+// 	32		-- "natural" 32-bit type (always int)
+// 	64		-- "natural" 64-bit type (long or long long)
+// 	LONG32		-- 32-bit type, traditionally long
+// 	QUAD		-- 64-bit type, traditionally long long
+// 	WORD		-- natural type of __WORDSIZE bits (int or long)
+// 	LONGWORD	-- type of __WORDSIZE bits, traditionally long
+//
+//    We distinguish WORD/LONGWORD, 32/LONG32, and 64/QUAD so that the
+//    conventional uses of `long' or `long long' type modifiers match the
+//    types we define, even when a less-adorned type would be the same size.
+//    This matters for (somewhat) portably writing printf/scanf formats for
+//    these types, where using the appropriate l or ll format modifiers can
+//    make the typedefs and the formats match up across all GNU platforms.  If
+//    we used `long' when it's 64 bits where `long long' is expected, then the
+//    compiler would warn about the formats not matching the argument types,
+//    and the programmer changing them to shut up the compiler would break the
+//    program's portability.
+//
+//    Here we assume what is presently the case in all the GCC configurations
+//    we support: long long is always 64 bits, long is always word/address size,
+//    and int is always 32 bits.
+
+// No need to mark the typedef with __extension__.
+// bits/typesizes.h -- underlying types for *_t.  Linux/x86-64 version.
+//    Copyright (C) 2012-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// See <bits/types.h> for the meaning of these macros.  This file exists so
+//    that <bits/types.h> need not vary across different GNU platforms.
+
+// X32 kernel interface is 64-bit.
+
+// Tell the libc code that off_t and off64_t are actually the same type
+//    for all ABI purposes, even if possibly expressed as different base types
+//    for C type-checking purposes.
+
+// Same for ino_t and ino64_t.
+
+// And for __rlim_t and __rlim64_t.
+
+// And for fsblkcnt_t, fsblkcnt64_t, fsfilcnt_t and fsfilcnt64_t.
+
+// And for getitimer, setitimer and rusage
+
+// Number of descriptors that can fit in an `fd_set'.
+
+// bits/time64.h -- underlying types for __time64_t.  Generic version.
+//    Copyright (C) 2018-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// Define __TIME64_T_TYPE so that it is always a 64-bit type.
+
+// If we already have 64-bit time type then use it.
+
+type X__dev_t = uint64                     /* types.h:145:25 */ // Type of device numbers.
+type X__uid_t = uint32                     /* types.h:146:25 */ // Type of user identifications.
+type X__gid_t = uint32                     /* types.h:147:25 */ // Type of group identifications.
+type X__ino_t = uint64                     /* types.h:148:25 */ // Type of file serial numbers.
+type X__ino64_t = uint64                   /* types.h:149:27 */ // Type of file serial numbers (LFS).
+type X__mode_t = uint32                    /* types.h:150:26 */ // Type of file attribute bitmasks.
+type X__nlink_t = uint64                   /* types.h:151:27 */ // Type of file link counts.
+type X__off_t = int64                      /* types.h:152:25 */ // Type of file sizes and offsets.
+type X__off64_t = int64                    /* types.h:153:27 */ // Type of file sizes and offsets (LFS).
+type X__pid_t = int32                      /* types.h:154:25 */ // Type of process identifications.
+type X__fsid_t = struct{ X__val [2]int32 } /* types.h:155:26 */ // Type of file system IDs.
+type X__clock_t = int64                    /* types.h:156:27 */ // Type of CPU usage counts.
+type X__rlim_t = uint64                    /* types.h:157:26 */ // Type for resource measurement.
+type X__rlim64_t = uint64                  /* types.h:158:28 */ // Type for resource measurement (LFS).
+type X__id_t = uint32                      /* types.h:159:24 */ // General type for IDs.
+type X__time_t = int64                     /* types.h:160:26 */ // Seconds since the Epoch.
+type X__useconds_t = uint32                /* types.h:161:30 */ // Count of microseconds.
+type X__suseconds_t = int64                /* types.h:162:31 */ // Signed count of microseconds.
+type X__suseconds64_t = int64              /* types.h:163:33 */
+
+type X__daddr_t = int32 /* types.h:165:27 */ // The type of a disk address.
+type X__key_t = int32   /* types.h:166:25 */ // Type of an IPC key.
+
+// Clock ID used in clock and timer functions.
+type X__clockid_t = int32 /* types.h:169:29 */
+
+// Timer ID returned by `timer_create'.
+type X__timer_t = uintptr /* types.h:172:12 */
+
+// Type to represent block size.
+type X__blksize_t = int64 /* types.h:175:29 */
+
+// Types from the Large File Support interface.
+
+// Type to count number of disk blocks.
+type X__blkcnt_t = int64   /* types.h:180:28 */
+type X__blkcnt64_t = int64 /* types.h:181:30 */
+
+// Type to count file system blocks.
+type X__fsblkcnt_t = uint64   /* types.h:184:30 */
+type X__fsblkcnt64_t = uint64 /* types.h:185:32 */
+
+// Type to count file system nodes.
+type X__fsfilcnt_t = uint64   /* types.h:188:30 */
+type X__fsfilcnt64_t = uint64 /* types.h:189:32 */
+
+// Type of miscellaneous file system fields.
+type X__fsword_t = int64 /* types.h:192:28 */
+
+type X__ssize_t = int64 /* types.h:194:27 */ // Type of a byte count, or error.
+
+// Signed long type used in system calls.
+type X__syscall_slong_t = int64 /* types.h:197:33 */
+// Unsigned long type used in system calls.
+type X__syscall_ulong_t = uint64 /* types.h:199:33 */
+
+// These few don't really vary by system, they always correspond
+//
+//	to one of the other defined types.
+type X__loff_t = X__off64_t /* types.h:203:19 */ // Type of file sizes and offsets (LFS).
+type X__caddr_t = uintptr   /* types.h:204:14 */
+
+// Duplicates info from stdint.h but this is used in unistd.h.
+type X__intptr_t = int64 /* types.h:207:25 */
+
+// Duplicate info from sys/socket.h.
+type X__socklen_t = uint32 /* types.h:210:23 */
+
+// C99: An integer type that can be accessed as an atomic entity,
+//
+//	even in the presence of asynchronous interrupts.
+//	It is not currently necessary for this to be machine-specific.
+type X__sig_atomic_t = int32 /* types.h:215:13 */
+
+// Seconds since the Epoch, visible to user code when time_t is too
+//    narrow only for consistency with the old way of widening too-narrow
+//    types.  User code should never use __time64_t.
+
+// wchar_t type related definitions.
+//    Copyright (C) 2000-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// The fallback definitions, for when __WCHAR_MAX__ or __WCHAR_MIN__
+//    are not defined, give the right value and type as long as both int
+//    and wchar_t are 32-bit types.  Adding L'\0' to a constant value
+//    ensures that the type is correct; it is necessary to use (L'\0' +
+//    0) rather than just L'\0' so that the type in C++ is the promoted
+//    version of wchar_t rather than the distinct wchar_t type itself.
+//    Because wchar_t in preprocessor #if expressions is treated as
+//    intmax_t or uintmax_t, the expression (L'\0' - 1) would have the
+//    wrong value for WCHAR_MAX in such expressions and so cannot be used
+//    to define __WCHAR_MAX in the unsigned case.
+
+// Determine the wordsize from the preprocessor defines.
+
+// Both x86-64 and x32 use the 64-bit system call interface.
+
+// Exact integral types.
+
+// Signed.
+// Define intN_t types.
+//    Copyright (C) 2017-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// bits/types.h -- definitions of __*_t types underlying *_t types.
+//    Copyright (C) 2002-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// Never include this file directly; use <sys/types.h> instead.
+
+type Int8_t = X__int8_t   /* stdint-intn.h:24:18 */
+type Int16_t = X__int16_t /* stdint-intn.h:25:19 */
+type Int32_t = X__int32_t /* stdint-intn.h:26:19 */
+type Int64_t = X__int64_t /* stdint-intn.h:27:19 */
+
+// Unsigned.
+// Define uintN_t types.
+//    Copyright (C) 2017-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// bits/types.h -- definitions of __*_t types underlying *_t types.
+//    Copyright (C) 2002-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// Never include this file directly; use <sys/types.h> instead.
+
+type Uint8_t = X__uint8_t   /* stdint-uintn.h:24:19 */
+type Uint16_t = X__uint16_t /* stdint-uintn.h:25:20 */
+type Uint32_t = X__uint32_t /* stdint-uintn.h:26:20 */
+type Uint64_t = X__uint64_t /* stdint-uintn.h:27:20 */
+
+// Small types.
+
+// Signed.
+type Int_least8_t = X__int_least8_t   /* stdint.h:43:24 */
+type Int_least16_t = X__int_least16_t /* stdint.h:44:25 */
+type Int_least32_t = X__int_least32_t /* stdint.h:45:25 */
+type Int_least64_t = X__int_least64_t /* stdint.h:46:25 */
+
+// Unsigned.
+type Uint_least8_t = X__uint_least8_t   /* stdint.h:49:25 */
+type Uint_least16_t = X__uint_least16_t /* stdint.h:50:26 */
+type Uint_least32_t = X__uint_least32_t /* stdint.h:51:26 */
+type Uint_least64_t = X__uint_least64_t /* stdint.h:52:26 */
+
+// Fast types.
+
+// Signed.
+type Int_fast8_t = int8   /* stdint.h:58:22 */
+type Int_fast16_t = int64 /* stdint.h:60:19 */
+type Int_fast32_t = int64 /* stdint.h:61:19 */
+type Int_fast64_t = int64 /* stdint.h:62:19 */
+
+// Unsigned.
+type Uint_fast8_t = uint8   /* stdint.h:71:24 */
+type Uint_fast16_t = uint64 /* stdint.h:73:27 */
+type Uint_fast32_t = uint64 /* stdint.h:74:27 */
+type Uint_fast64_t = uint64 /* stdint.h:75:27 */
+
+// Types for `void *' pointers.
+type Intptr_t = int64   /* stdint.h:87:19 */
+type Uintptr_t = uint64 /* stdint.h:90:27 */
+
+// Largest integral types.
+type Intmax_t = X__intmax_t   /* stdint.h:101:21 */
+type Uintmax_t = X__uintmax_t /* stdint.h:102:22 */
+
+// A null pointer constant.
+
+// XPG requires a few symbols from <sys/wait.h> being defined.
+// Definitions of flag bits for `waitpid' et al.
+//    Copyright (C) 1992-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// Bits in the third argument to `waitpid'.
+
+// Bits in the fourth argument to `waitid'.
+
+// Definitions of status bits for `wait' et al.
+//    Copyright (C) 1992-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// Everything extant so far uses these same bits.
+
+// If WIFEXITED(STATUS), the low-order 8 bits of the status.
+
+// If WIFSIGNALED(STATUS), the terminating signal.
+
+// If WIFSTOPPED(STATUS), the signal that stopped the child.
+
+// Nonzero if STATUS indicates normal termination.
+
+// Nonzero if STATUS indicates termination by a signal.
+
+// Nonzero if STATUS indicates the child is stopped.
+
+// Nonzero if STATUS indicates the child continued after a stop.  We only
+//    define this if <bits/waitflags.h> provides the WCONTINUED flag bit.
+
+// Nonzero if STATUS indicates the child dumped core.
+
+// Macros for constructing status values.
+
+// Define the macros <sys/wait.h> also would define this way.
+
+// _FloatN API tests for enablement.
+// Macros to control TS 18661-3 glibc features on x86.
+//    Copyright (C) 2017-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// Copyright (C) 1991-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// Defined to 1 if the current compiler invocation provides a
+//    floating-point type with the IEEE 754 binary128 format, and this
+//    glibc includes corresponding *f128 interfaces for it.  The required
+//    libgcc support was added some time after the basic compiler
+//    support, for x86_64 and x86.
+
+// Defined to 1 if __HAVE_FLOAT128 is 1 and the type is ABI-distinct
+//    from the default float, double and long double types in this glibc.
+
+// Defined to 1 if the current compiler invocation provides a
+//    floating-point type with the right format for _Float64x, and this
+//    glibc includes corresponding *f64x interfaces for it.
+
+// Defined to 1 if __HAVE_FLOAT64X is 1 and _Float64x has the format
+//    of long double.  Otherwise, if __HAVE_FLOAT64X is 1, _Float64x has
+//    the format of _Float128, which must be different from that of long
+//    double.
+
+// Defined to concatenate the literal suffix to be used with _Float128
+//    types, if __HAVE_FLOAT128 is 1.
+
+// Defined to a complex binary128 type if __HAVE_FLOAT128 is 1.
+
+// The remaining of this file provides support for older compilers.
+
+// The type _Float128 exists only since GCC 7.0.
+
+// __builtin_huge_valf128 doesn't exist before GCC 7.0.
+
+// Older GCC has only a subset of built-in functions for _Float128 on
+//    x86, and __builtin_infq is not usable in static initializers.
+//    Converting a narrower sNaN to _Float128 produces a quiet NaN, so
+//    attempts to use _Float128 sNaNs will not work properly with older
+//    compilers.
+
+// In math/math.h, __MATH_TG will expand signbit to __builtin_signbit*,
+//    e.g.: __builtin_signbitf128, before GCC 6.  However, there has never
+//    been a __builtin_signbitf128 in GCC and the type-generic builtin is
+//    only available since GCC 6.
+
+// Macros to control TS 18661-3 glibc features where the same
+//    definitions are appropriate for all platforms.
+//    Copyright (C) 2017-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// Copyright (C) 1991-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// Properties of long double type.  ldbl-96 version.
+//    Copyright (C) 2016-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License  published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// long double is distinct from double, so there is nothing to
+//    define here.
+
+// This header should be included at the bottom of each bits/floatn.h.
+//    It defines the following macros for each _FloatN and _FloatNx type,
+//    where the same definitions, or definitions based only on the macros
+//    in bits/floatn.h, are appropriate for all glibc configurations.
+
+// Defined to 1 if the current compiler invocation provides a
+//    floating-point type with the right format for this type, and this
+//    glibc includes corresponding *fN or *fNx interfaces for it.
+
+// Defined to 1 if the corresponding __HAVE_<type> macro is 1 and the
+//    type is the first with its format in the sequence of (the default
+//    choices for) float, double, long double, _Float16, _Float32,
+//    _Float64, _Float128, _Float32x, _Float64x, _Float128x for this
+//    glibc; that is, if functions present once per floating-point format
+//    rather than once per type are present for this type.
+//
+//    All configurations supported by glibc have _Float32 the same format
+//    as float, _Float64 and _Float32x the same format as double, the
+//    _Float64x the same format as either long double or _Float128.  No
+//    configurations support _Float128x or, as of GCC 7, have compiler
+//    support for a type meeting the requirements for _Float128x.
+
+// Defined to 1 if the corresponding _FloatN type is not binary compatible
+//    with the corresponding ISO C type in the current compilation unit as
+//    opposed to __HAVE_DISTINCT_FLOATN, which indicates the default types built
+//    in glibc.
+
+// Defined to 1 if any _FloatN or _FloatNx types that are not
+//    ABI-distinct are however distinct types at the C language level (so
+//    for the purposes of __builtin_types_compatible_p and _Generic).
+
+// Defined to concatenate the literal suffix to be used with _FloatN
+//    or _FloatNx types, if __HAVE_<type> is 1.  The corresponding
+//    literal suffixes exist since GCC 7, for C only.
+
+// Defined to a complex type if __HAVE_<type> is 1.
+
+// The remaining of this file provides support for older compilers.
+
+// If double, long double and _Float64 all have the same set of
+//    values, TS 18661-3 requires the usual arithmetic conversions on
+//    long double and _Float64 to produce _Float64.  For this to be the
+//    case when building with a compiler without a distinct _Float64
+//    type, _Float64 must be a typedef for long double, not for
+//    double.
+
+// Returned by `div'.
+type Div_t = struct {
+	Quot int32
+	Rem  int32
+} /* stdlib.h:63:5 */
+
+// Returned by `ldiv'.
+type Ldiv_t = struct {
+	Quot int64
+	Rem  int64
+} /* stdlib.h:71:5 */
+
+// Returned by `lldiv'.
+type Lldiv_t = struct {
+	Quot int64
+	Rem  int64
+} /* stdlib.h:81:5 */
+
+// Copyright (C) 1991-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+//	POSIX Standard: 2.6 Primitive System Data Types	<sys/types.h>
+
+// Copyright (C) 1991-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// bits/types.h -- definitions of __*_t types underlying *_t types.
+//    Copyright (C) 2002-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// Never include this file directly; use <sys/types.h> instead.
+
+type U_char = X__u_char     /* types.h:33:18 */
+type U_short = X__u_short   /* types.h:34:19 */
+type U_int = X__u_int       /* types.h:35:17 */
+type U_long = X__u_long     /* types.h:36:18 */
+type Quad_t = X__quad_t     /* types.h:37:18 */
+type U_quad_t = X__u_quad_t /* types.h:38:20 */
+type Fsid_t = X__fsid_t     /* types.h:39:18 */
+type Loff_t = X__loff_t     /* types.h:42:18 */
+
+type Ino_t = X__ino64_t /* types.h:49:19 */
+
+type Dev_t = X__dev_t /* types.h:59:17 */
+
+type Gid_t = X__gid_t /* types.h:64:17 */
+
+type Mode_t = X__mode_t /* types.h:69:18 */
+
+type Nlink_t = X__nlink_t /* types.h:74:19 */
+
+type Uid_t = X__uid_t /* types.h:79:17 */
+
+type Off_t = X__off64_t /* types.h:87:19 */
+
+type Pid_t = X__pid_t /* types.h:97:17 */
+
+type Id_t = X__id_t /* types.h:103:16 */
+
+type Ssize_t = X__ssize_t /* types.h:108:19 */
+
+type Daddr_t = X__daddr_t /* types.h:114:19 */
+type Caddr_t = X__caddr_t /* types.h:115:19 */
+
+type Key_t = X__key_t /* types.h:121:17 */
+
+// bits/types.h -- definitions of __*_t types underlying *_t types.
+//    Copyright (C) 2002-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// Never include this file directly; use <sys/types.h> instead.
+
+// Returned by `clock'.
+type Clock_t = X__clock_t /* clock_t.h:7:19 */
+
+// bits/types.h -- definitions of __*_t types underlying *_t types.
+//    Copyright (C) 2002-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// Never include this file directly; use <sys/types.h> instead.
+
+// Clock ID used in clock and timer functions.
+type Clockid_t = X__clockid_t /* clockid_t.h:7:21 */
+
+// bits/types.h -- definitions of __*_t types underlying *_t types.
+//    Copyright (C) 2002-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// Never include this file directly; use <sys/types.h> instead.
+
+// Returned by `time'.
+type Time_t = X__time_t /* time_t.h:10:18 */
+
+// bits/types.h -- definitions of __*_t types underlying *_t types.
+//    Copyright (C) 2002-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// Never include this file directly; use <sys/types.h> instead.
+
+// Timer ID returned by `timer_create'.
+type Timer_t = X__timer_t /* timer_t.h:7:19 */
+
+// Copyright (C) 1989-2022 Free Software Foundation, Inc.
+//
+// This file is part of GCC.
+//
+// GCC is free software; you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation; either version 3, or (at your option)
+// any later version.
+//
+// GCC is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// Under Section 7 of GPL version 3, you are granted additional
+// permissions described in the GCC Runtime Library Exception, version
+// 3.1, as published by the Free Software Foundation.
+//
+// You should have received a copy of the GNU General Public License and
+// a copy of the GCC Runtime Library Exception along with this program;
+// see the files COPYING3 and COPYING.RUNTIME respectively.  If not, see
+// <http://www.gnu.org/licenses/>.
+
+// ISO C Standard:  7.17  Common definitions  <stddef.h>
+
+// Any one of these symbols __need_* means that GNU libc
+//    wants us just to define one data type.  So don't define
+//    the symbols that indicate this file's entire job has been done.
+
+// This avoids lossage on SunOS but only if stdtypes.h comes first.
+//    There's no way to win with the other order!  Sun lossage.
+
+// Sequent's header files use _PTRDIFF_T_ in some conflicting way.
+//    Just ignore it.
+
+// On VxWorks, <type/vxTypesBase.h> may have defined macros like
+//    _TYPE_size_t which will typedef size_t.  fixincludes patched the
+//    vxTypesBase.h so that this macro is only defined if _GCC_SIZE_T is
+//    not defined, and so that defining this macro defines _GCC_SIZE_T.
+//    If we find that the macros are still defined at this point, we must
+//    invoke them so that the type is defined as expected.
+
+// In case nobody has defined these types, but we aren't running under
+//    GCC 2.00, make sure that __PTRDIFF_TYPE__, __SIZE_TYPE__, and
+//    __WCHAR_TYPE__ have reasonable values.  This can happen if the
+//    parts of GCC is compiled by an older compiler, that actually
+//    include gstddef.h, such as collect2.
+
+// Signed type of difference of two pointers.
+
+// Define this type if we are doing the whole job,
+//    or if we want this type in particular.
+
+// Unsigned type of `sizeof' something.
+
+// Define this type if we are doing the whole job,
+//    or if we want this type in particular.
+
+// Wide character type.
+//    Locale-writers should change this as necessary to
+//    be big enough to hold unique values not between 0 and 127,
+//    and not (wchar_t) -1, for each defined multibyte character.
+
+// Define this type if we are doing the whole job,
+//    or if we want this type in particular.
+
+// A null pointer constant.
+
+// Old compatibility names for C types.
+type Ulong = uint64  /* types.h:148:27 */
+type Ushort = uint16 /* types.h:149:28 */
+type Uint = uint32   /* types.h:150:22 */
+
+// These size-specific names are used by some of the inet code.
+
+// Define intN_t types.
+//    Copyright (C) 2017-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// These were defined by ISO C without the first `_'.
+type U_int8_t = X__uint8_t   /* types.h:158:19 */
+type U_int16_t = X__uint16_t /* types.h:159:20 */
+type U_int32_t = X__uint32_t /* types.h:160:20 */
+type U_int64_t = X__uint64_t /* types.h:161:20 */
+
+type Register_t = int32 /* types.h:164:13 */
+
+// It also defines `fd_set' and the FD_* macros for `select'.
+// `fd_set' type and related macros, and `select'/`pselect' declarations.
+//    Copyright (C) 1996-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+//	POSIX 1003.1g: 6.2 Select from File Descriptor Sets <sys/select.h>
+
+// Copyright (C) 1991-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// Get definition of needed basic types.
+// bits/types.h -- definitions of __*_t types underlying *_t types.
+//    Copyright (C) 2002-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// Never include this file directly; use <sys/types.h> instead.
+
+// Get __FD_* definitions.
+// Copyright (C) 1997-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// We don't use `memset' because this would require a prototype and
+//    the array isn't too big.
+
+// Get sigset_t.
+
+type X__sigset_t = struct{ X__val [16]uint64 } /* __sigset_t.h:8:3 */
+
+// A set of signals to be blocked, unblocked, or waited for.
+type Sigset_t = X__sigset_t /* sigset_t.h:7:20 */
+
+// Get definition of timer specification structures.
+
+// bits/types.h -- definitions of __*_t types underlying *_t types.
+//    Copyright (C) 2002-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// Never include this file directly; use <sys/types.h> instead.
+
+// A time value that is accurate to the nearest
+//
+//	microsecond but also has a range of years.
+type Stimeval = struct {
+	Tv_sec  X__time_t
+	Tv_usec X__suseconds_t
+} /* struct_timeval.h:8:1 */
+
+// NB: Include guard matches what <linux/time.h> uses.
+
+// bits/types.h -- definitions of __*_t types underlying *_t types.
+//    Copyright (C) 2002-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// Never include this file directly; use <sys/types.h> instead.
+
+// Endian macros for string.h functions
+//    Copyright (C) 1992-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <http://www.gnu.org/licenses/>.
+
+// POSIX.1b structure for a time value.  This is like a `struct timeval' but
+//
+//	has nanoseconds instead of microseconds.
+type Stimespec = struct {
+	Tv_sec  X__time_t
+	Tv_nsec X__syscall_slong_t
+} /* struct_timespec.h:11:1 */
+
+type Suseconds_t = X__suseconds_t /* select.h:43:23 */
+
+// The fd_set member is required to be an array of longs.
+type X__fd_mask = int64 /* select.h:49:18 */
+
+// Some versions of <linux/posix_types.h> define this macros.
+// It's easier to assume 8-bit bytes than to get CHAR_BIT.
+
+// fd_set for select and pselect.
+type Fd_set = struct{ X__fds_bits [16]X__fd_mask } /* select.h:70:5 */
+
+// Maximum number of file descriptors in `fd_set'.
+
+// Sometimes the fd_set member is assumed to have this type.
+type Fd_mask = X__fd_mask /* select.h:77:19 */
+
+// Define some inlines helping to catch common problems.
+
+type Blksize_t = X__blksize_t /* types.h:185:21 */
+
+// Types from the Large File Support interface.
+type Blkcnt_t = X__blkcnt64_t     /* types.h:205:22 */ // Type to count number of disk blocks.
+type Fsblkcnt_t = X__fsblkcnt64_t /* types.h:209:24 */ // Type to count file system blocks.
+type Fsfilcnt_t = X__fsfilcnt64_t /* types.h:213:24 */ // Type to count file system inodes.
+
+// Now add the thread types.
+// Declaration of common pthread types for all architectures.
+//    Copyright (C) 2017-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// For internal mutex and condition variable definitions.
+// Common threading primitives definitions for both POSIX and C11.
+//    Copyright (C) 2017-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// Arch-specific definitions.  Each architecture must define the following
+//    macros to define the expected sizes of pthread data types:
+//
+//    __SIZEOF_PTHREAD_ATTR_T        - size of pthread_attr_t.
+//    __SIZEOF_PTHREAD_MUTEX_T       - size of pthread_mutex_t.
+//    __SIZEOF_PTHREAD_MUTEXATTR_T   - size of pthread_mutexattr_t.
+//    __SIZEOF_PTHREAD_COND_T        - size of pthread_cond_t.
+//    __SIZEOF_PTHREAD_CONDATTR_T    - size of pthread_condattr_t.
+//    __SIZEOF_PTHREAD_RWLOCK_T      - size of pthread_rwlock_t.
+//    __SIZEOF_PTHREAD_RWLOCKATTR_T  - size of pthread_rwlockattr_t.
+//    __SIZEOF_PTHREAD_BARRIER_T     - size of pthread_barrier_t.
+//    __SIZEOF_PTHREAD_BARRIERATTR_T - size of pthread_barrierattr_t.
+//
+//    The additional macro defines any constraint for the lock alignment
+//    inside the thread structures:
+//
+//    __LOCK_ALIGNMENT - for internal lock/futex usage.
+//
+//    Same idea but for the once locking primitive:
+//
+//    __ONCE_ALIGNMENT - for pthread_once_t/once_flag definition.
+
+// Copyright (C) 2002-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// Determine the wordsize from the preprocessor defines.
+
+// Both x86-64 and x32 use the 64-bit system call interface.
+
+// Monotonically increasing wide counters (at least 62 bits).
+//    Copyright (C) 2016-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// Counter that is monotonically increasing (by less than 2**31 per
+//
+//	increment), with a single writer, and an arbitrary number of
+//	readers.
+type X__atomic_wide_counter = struct{ X__value64 uint64 } /* atomic_wide_counter.h:33:3 */
+
+// Common definition of pthread_mutex_t.
+
+type S__pthread_internal_list = struct {
+	X__prev uintptr
+	X__next uintptr
+} /* thread-shared-types.h:51:9 */
+
+// Common definition of pthread_mutex_t.
+
+type X__pthread_list_t = S__pthread_internal_list /* thread-shared-types.h:55:3 */
+
+type S__pthread_internal_slist = struct{ X__next uintptr } /* thread-shared-types.h:57:9 */
+
+type X__pthread_slist_t = S__pthread_internal_slist /* thread-shared-types.h:60:3 */
+
+// Arch-specific mutex definitions.  A generic implementation is provided
+//    by sysdeps/nptl/bits/struct_mutex.h.  If required, an architecture
+//    can override it by defining:
+//
+//    1. struct __pthread_mutex_s (used on both pthread_mutex_t and mtx_t
+//       definition).  It should contains at least the internal members
+//       defined in the generic version.
+//
+//    2. __LOCK_ALIGNMENT for any extra attribute for internal lock used with
+//       atomic operations.
+//
+//    3. The macro __PTHREAD_MUTEX_INITIALIZER used for static initialization.
+//       It should initialize the mutex internal flag.
+
+// x86 internal mutex struct definitions.
+//    Copyright (C) 2019-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <http://www.gnu.org/licenses/>.
+
+type S__pthread_mutex_s = struct {
+	X__lock    int32
+	X__count   uint32
+	X__owner   int32
+	X__nusers  uint32
+	X__kind    int32
+	X__spins   int16
+	X__elision int16
+	X__list    X__pthread_list_t
+} /* struct_mutex.h:22:1 */
+
+// Arch-sepecific read-write lock definitions.  A generic implementation is
+//    provided by struct_rwlock.h.  If required, an architecture can override it
+//    by defining:
+//
+//    1. struct __pthread_rwlock_arch_t (used on pthread_rwlock_t definition).
+//       It should contain at least the internal members defined in the
+//       generic version.
+//
+//    2. The macro __PTHREAD_RWLOCK_INITIALIZER used for static initialization.
+//       It should initialize the rwlock internal type.
+
+// x86 internal rwlock struct definitions.
+//    Copyright (C) 2019-2022 Free Software Foundation, Inc.
+//
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <http://www.gnu.org/licenses/>.
+
+type S__pthread_rwlock_arch_t = struct {
+	X__readers       uint32
+	X__writers       uint32
+	X__wrphase_futex uint32
+	X__writers_futex uint32
+	X__pad3          uint32
+	X__pad4          uint32
+	X__cur_writer    int32
+	X__shared        int32
+	X__rwelision     int8
+	X__pad1          [7]uint8
+	X__pad2          uint64
+	X__flags         uint32
+	__ccgo_pad1      [4]byte
+} /* struct_rwlock.h:23:1 */
+
+// Common definition of pthread_cond_t.
+
+type S__pthread_cond_s = struct {
+	X__wseq         X__atomic_wide_counter
+	X__g1_start     X__atomic_wide_counter
+	X__g_refs       [2]uint32
+	X__g_size       [2]uint32
+	X__g1_orig_size uint32
+	X__wrefs        uint32
+	X__g_signals    [2]uint32
+} /* thread-shared-types.h:94:1 */
+
+type X__tss_t = uint32  /* thread-shared-types.h:105:22 */
+type X__thrd_t = uint64 /* thread-shared-types.h:106:27 */
+
+type X__once_flag = struct{ X__data int32 } /* thread-shared-types.h:111:3 */
+
+// Thread identifiers.  The structure of the attribute type is not
+//
+//	exposed on purpose.
+type Pthread_t = uint64 /* pthreadtypes.h:27:27 */
+
+// Data structures for mutex handling.  The structure of the attribute
+//
+//	type is not exposed on purpose.
+type Pthread_mutexattr_t = struct {
+	__ccgo_pad1 [0]uint32
+	X__size     [4]int8
+} /* pthreadtypes.h:36:3 */
+
+// Data structure for condition variable handling.  The structure of
+//
+//	the attribute type is not exposed on purpose.
+type Pthread_condattr_t = struct {
+	__ccgo_pad1 [0]uint32
+	X__size     [4]int8
+} /* pthreadtypes.h:45:3 */
+
+// Keys for thread-specific data
+type Pthread_key_t = uint32 /* pthreadtypes.h:49:22 */
+
+// Once-only execution
+type Pthread_once_t = int32 /* pthreadtypes.h:53:30 */
+
+type Spthread_attr_t = struct {
+	__ccgo_pad1 [0]uint64
+	X__size     [56]int8
+} /* pthreadtypes.h:56:1 */
+
+type Pthread_attr_t = Spthread_attr_t /* pthreadtypes.h:62:30 */
+
+type Pthread_mutex_t = struct{ X__data S__pthread_mutex_s } /* pthreadtypes.h:72:3 */
+
+type Pthread_cond_t = struct{ X__data S__pthread_cond_s } /* pthreadtypes.h:80:3 */
+
+// Data structure for reader-writer lock variable handling.  The
+//
+//	structure of the attribute type is deliberately not exposed.
+type Pthread_rwlock_t = struct{ X__data S__pthread_rwlock_arch_t } /* pthreadtypes.h:91:3 */
+
+type Pthread_rwlockattr_t = struct {
+	__ccgo_pad1 [0]uint64
+	X__size     [8]int8
+} /* pthreadtypes.h:97:3 */
+
+// POSIX spinlock data type.
+type Pthread_spinlock_t = int32 /* pthreadtypes.h:103:22 */
+
+// POSIX barriers data type.  The structure of the type is
+//
+//	deliberately not exposed.
+type Pthread_barrier_t = struct {
+	__ccgo_pad1 [0]uint64
+	X__size     [32]int8
+} /* pthreadtypes.h:112:3 */
+
+type Pthread_barrierattr_t = struct {
+	__ccgo_pad1 [0]uint32
+	X__size     [4]int8
+} /* pthreadtypes.h:118:3 */
+
+// Reentrant versions of the `random' family of functions.
+//    These functions all use the following data structure to contain
+//    state, rather than global state variables.
+
+type Srandom_data = struct {
+	Fptr        uintptr
+	Rptr        uintptr
+	State       uintptr
+	Rand_type   int32
+	Rand_deg    int32
+	Rand_sep    int32
+	__ccgo_pad1 [4]byte
+	End_ptr     uintptr
+} /* stdlib.h:424:1 */
+
+// Data structure for communication with thread safe versions.  This
+//
+//	type is to be regarded as opaque.  It's only exported because users
+//	have to allocate objects of this type.
+type Sdrand48_data = struct {
+	X__x     [3]uint16
+	X__old_x [3]uint16
+	X__c     uint16
+	X__init  uint16
+	X__a     uint64
+} /* stdlib.h:491:1 */
+
+// Shorthand for type of comparison functions.
+type X__compar_fn_t = uintptr /* stdlib.h:829:13 */
+
+// Floating-point inline functions for stdlib.h.
+//    Copyright (C) 2012-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+// Define some macros helping to catch buffer overflows.
+
+// Macros to control TS 18661-3 glibc features on x86.
+//    Copyright (C) 2017-2022 Free Software Foundation, Inc.
+//    This file is part of the GNU C Library.
+//
+//    The GNU C Library is free software; you can redistribute it and/or
+//    modify it under the terms of the GNU Lesser General Public
+//    License as published by the Free Software Foundation; either
+//    version 2.1 of the License, or (at your option) any later version.
+//
+//    The GNU C Library is distributed in the hope that it will be useful,
+//    but WITHOUT ANY WARRANTY; without even the implied warranty of
+//    MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU
+//    Lesser General Public License for more details.
+//
+//    You should have received a copy of the GNU Lesser General Public
+//    License along with the GNU C Library; if not, see
+//    <https://www.gnu.org/licenses/>.
+
+type TSStateId = Uint16_t /* parser.h:16:18 */
+
+type TSSymbol = Uint16_t  /* parser.h:19:18 */
+type TSFieldId = Uint16_t /* parser.h:20:18 */
+type STSLanguage = struct {
+	Version                   Uint32_t
+	Symbol_count              Uint32_t
+	Alias_count               Uint32_t
+	Token_count               Uint32_t
+	External_token_count      Uint32_t
+	State_count               Uint32_t
+	Large_state_count         Uint32_t
+	Production_id_count       Uint32_t
+	Field_count               Uint32_t
+	Max_alias_sequence_length Uint16_t
+	__ccgo_pad1               [2]byte
+	Parse_table               uintptr
+	Small_parse_table         uintptr
+	Small_parse_table_map     uintptr
+	Parse_actions             uintptr
+	Symbol_names              uintptr
+	Field_names               uintptr
+	Field_map_slices          uintptr
+	Field_map_entries         uintptr
+	Symbol_metadata           uintptr
+	Public_symbol_map         uintptr
+	Alias_map                 uintptr
+	Alias_sequences           uintptr
+	Lex_modes                 uintptr
+	Lex_fn                    uintptr
+	Keyword_lex_fn            uintptr
+	Keyword_capture_token     TSSymbol
+	__ccgo_pad2               [6]byte
+	External_scanner          struct {
+		States      uintptr
+		Symbol_map  uintptr
+		Create      uintptr
+		Destroy     uintptr
+		Scan        uintptr
+		Serialize   uintptr
+		Deserialize uintptr
+	}
+} /* parser.h:21:9 */
+
+type TSLanguage = STSLanguage /* parser.h:21:27 */
+
+type TSFieldMapEntry = struct {
+	Field_id    TSFieldId
+	Child_index Uint8_t
+	Inherited   uint8
+} /* parser.h:28:3 */
+
+type TSFieldMapSlice = struct {
+	Index  Uint16_t
+	Length Uint16_t
+} /* parser.h:33:3 */
+
+type TSSymbolMetadata = struct {
+	Visible   uint8
+	Named     uint8
+	Supertype uint8
+} /* parser.h:39:3 */
+
+type STSLexer = struct {
+	Lookahead                  Int32_t
+	Result_symbol              TSSymbol
+	__ccgo_pad1                [2]byte
+	Advance                    uintptr
+	Mark_end                   uintptr
+	Get_column                 uintptr
+	Is_at_included_range_start uintptr
+	Eof                        uintptr
+} /* parser.h:41:9 */
+
+type TSLexer = STSLexer /* parser.h:41:24 */
+
+type TSParseActionType = uint32 /* parser.h:58:3 */
+
+type TSParseAction = struct {
+	Shift struct {
+		Type        Uint8_t
+		__ccgo_pad1 [1]byte
+		State       TSStateId
+		Extra       uint8
+		Repetition  uint8
+	}
+	__ccgo_pad1 [2]byte
+} /* parser.h:75:3 */
+
+type TSLexMode = struct {
+	Lex_state          Uint16_t
+	External_lex_state Uint16_t
+} /* parser.h:80:3 */
+
+type TSParseActionEntry = struct{ Action TSParseAction } /* parser.h:88:3 */
+
+var ts_symbol_names = [66]uintptr{ts, ts + 4, ts + 20, ts + 28, ts + 30, ts + 32, ts + 35, ts + 38, ts + 40, ts + 42, ts + 51, ts + 53, ts + 51, ts + 74, ts + 78, ts + 109, ts + 109, ts + 125, ts + 127, ts + 125, ts + 150, ts + 154, ts + 169, ts + 184, ts + 199, ts + 214, ts + 227, ts + 240, ts + 248, ts + 265, ts + 281, ts + 292, ts + 303, ts + 305, ts + 307, ts + 309, ts + 329, ts + 361, ts + 389, ts + 423, ts + 453, ts + 462, ts + 468, ts + 488, ts + 493, ts + 506, ts + 511, ts + 522, ts + 533, ts + 547, ts + 554, ts + 568, ts + 592, ts + 608, ts + 634, ts + 642, ts + 648, ts + 654, ts + 667, ts + 684, ts + 701, ts + 723, ts + 755, ts + 789, ts + 803, ts + 817} /* parser.c:87:19 */
+
+var ts_symbol_map = [66]TSSymbol{TSSymbol(Ts_builtin_sym_end), Aux_sym_document_token1, Sym_comment, Anon_sym_LBRACK, Anon_sym_RBRACK, Anon_sym_LBRACK_LBRACK, Anon_sym_RBRACK_RBRACK, Anon_sym_EQ, Anon_sym_DOT, Sym_bare_key, Anon_sym_DQUOTE, Aux_sym__basic_string_token1, Anon_sym_DQUOTE, Anon_sym_DQUOTE_DQUOTE_DQUOTE, Aux_sym__multiline_basic_string_token1, Sym_escape_sequence, Sym_escape_sequence, Anon_sym_SQUOTE, Aux_sym__literal_string_token1, Anon_sym_SQUOTE, Anon_sym_SQUOTE_SQUOTE_SQUOTE, Aux_sym_integer_token1, Aux_sym_integer_token2, Aux_sym_integer_token3, Aux_sym_integer_token4, Aux_sym_float_token1, Aux_sym_float_token2, Sym_boolean, Sym_offset_date_time, Sym_local_date_time, Sym_local_date, Sym_local_time, Anon_sym_COMMA, Anon_sym_LBRACE, Anon_sym_RBRACE, Sym__line_ending_or_eof, Sym__multiline_basic_string_content, Sym__multiline_basic_string_end, Sym__multiline_literal_string_content, Sym__multiline_literal_string_end, Sym_document, Sym_table, Sym_table_array_element, Sym_pair, Sym__inline_pair, Sym__key, Sym_dotted_key, Sym_quoted_key, Sym__inline_value, Sym_string, Sym__basic_string, Sym__multiline_basic_string, Sym__literal_string, Sym__multiline_literal_string, Sym_integer, Sym_float, Sym_array, Sym_inline_table, Aux_sym_document_repeat1, Aux_sym_document_repeat2, Aux_sym__basic_string_repeat1, Aux_sym__multiline_basic_string_repeat1, Aux_sym__multiline_literal_string_repeat1, Aux_sym_array_repeat1, Aux_sym_array_repeat2, Aux_sym_inline_table_repeat1} /* parser.c:156:17 */
+
+var ts_symbol_metadata = [66]TSSymbolMetadata{{Named: uint8(True)}, {}, {Visible: uint8(True), Named: uint8(True)}, {Visible: uint8(True)}, {Visible: uint8(True)}, {Visible: uint8(True)}, {Visible: uint8(True)}, {Visible: uint8(True)}, {Visible: uint8(True)}, {Visible: uint8(True), Named: uint8(True)}, {Visible: uint8(True)}, {}, {Visible: uint8(True)}, {Visible: uint8(True)}, {}, {Visible: uint8(True), Named: uint8(True)}, {Visible: uint8(True), Named: uint8(True)}, {Visible: uint8(True)}, {}, {Visible: uint8(True)}, {Visible: uint8(True)}, {}, {}, {}, {}, {}, {}, {Visible: uint8(True), Named: uint8(True)}, {Visible: uint8(True), Named: uint8(True)}, {Visible: uint8(True), Named: uint8(True)}, {Visible: uint8(True), Named: uint8(True)}, {Visible: uint8(True), Named: uint8(True)}, {Visible: uint8(True)}, {Visible: uint8(True)}, {Visible: uint8(True)}, {Named: uint8(True)}, {Named: uint8(True)}, {Named: uint8(True)}, {Named: uint8(True)}, {Named: uint8(True)}, {Visible: uint8(True), Named: uint8(True)}, {Visible: uint8(True), Named: uint8(True)}, {Visible: uint8(True), Named: uint8(True)}, {Visible: uint8(True), Named: uint8(True)}, {Named: uint8(True)}, {Named: uint8(True)}, {Visible: uint8(True), Named: uint8(True)}, {Visible: uint8(True), Named: uint8(True)}, {Named: uint8(True)}, {Visible: uint8(True), Named: uint8(True)}, {Named: uint8(True)}, {Named: uint8(True)}, {Named: uint8(True)}, {Named: uint8(True)}, {Visible: uint8(True), Named: uint8(True)}, {Visible: uint8(True), Named: uint8(True)}, {Visible: uint8(True), Named: uint8(True)}, {Visible: uint8(True), Named: uint8(True)}, {}, {}, {}, {}, {}, {}, {}, {}} /* parser.c:225:31 */
+
+var ts_alias_sequences = [2][8]TSSymbol{{0: TSSymbol(0)}, {1: Sym_pair}} /* parser.c:492:17 */
+
+var ts_non_terminal_alias_map = [5]Uint16_t{
+	Sym__inline_pair, Uint16_t(2),
+	Sym__inline_pair,
+	Sym_pair,
+	Uint16_t(0),
+} /* parser.c:499:17 */
+
+func ts_lex(tls *libc.TLS, lexer uintptr, state TSStateId) uint8 { /* parser.c:506:13: */
+	var result uint8
+	var skip uint8
+	var eof uint8
+	var lookahead Int32_t
+	result = uint8(False)
+	skip = uint8(False)
+	eof = uint8(False)
+	goto start
+next_state:
+	(*struct {
+		f func(*libc.TLS, uintptr, uint8)
+	})(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Advance})).f(tls, lexer, skip)
+start:
+	skip = uint8(False)
+	lookahead = (*TSLexer)(unsafe.Pointer(lexer)).Lookahead
+
+	eof = (*struct {
+		f func(*libc.TLS, uintptr) uint8
+	})(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Eof})).f(tls, lexer)
+	switch int32(state) {
+	case 0:
+		goto __2
+	case 1:
+		goto __3
+	case 2:
+		goto __4
+	case 3:
+		goto __5
+	case 4:
+		goto __6
+	case 5:
+		goto __7
+	case 6:
+		goto __8
+	case 7:
+		goto __9
+	case 8:
+		goto __10
+	case 9:
+		goto __11
+	case 10:
+		goto __12
+	case 11:
+		goto __13
+	case 12:
+		goto __14
+	case 13:
+		goto __15
+	case 14:
+		goto __16
+	case 15:
+		goto __17
+	case 16:
+		goto __18
+	case 17:
+		goto __19
+	case 18:
+		goto __20
+	case 19:
+		goto __21
+	case 20:
+		goto __22
+	case 21:
+		goto __23
+	case 22:
+		goto __24
+	case 23:
+		goto __25
+	case 24:
+		goto __26
+	case 25:
+		goto __27
+	case 26:
+		goto __28
+	case 27:
+		goto __29
+	case 28:
+		goto __30
+	case 29:
+		goto __31
+	case 30:
+		goto __32
+	case 31:
+		goto __33
+	case 32:
+		goto __34
+	case 33:
+		goto __35
+	case 34:
+		goto __36
+	case 35:
+		goto __37
+	case 36:
+		goto __38
+	case 37:
+		goto __39
+	case 38:
+		goto __40
+	case 39:
+		goto __41
+	case 40:
+		goto __42
+	case 41:
+		goto __43
+	case 42:
+		goto __44
+	case 43:
+		goto __45
+	case 44:
+		goto __46
+	case 45:
+		goto __47
+	case 46:
+		goto __48
+	case 47:
+		goto __49
+	case 48:
+		goto __50
+	case 49:
+		goto __51
+	case 50:
+		goto __52
+	case 51:
+		goto __53
+	case 52:
+		goto __54
+	case 53:
+		goto __55
+	case 54:
+		goto __56
+	case 55:
+		goto __57
+	case 56:
+		goto __58
+	case 57:
+		goto __59
+	case 58:
+		goto __60
+	case 59:
+		goto __61
+	case 60:
+		goto __62
+	case 61:
+		goto __63
+	case 62:
+		goto __64
+	case 63:
+		goto __65
+	case 64:
+		goto __66
+	case 65:
+		goto __67
+	case 66:
+		goto __68
+	case 67:
+		goto __69
+	case 68:
+		goto __70
+	case 69:
+		goto __71
+	case 70:
+		goto __72
+	case 71:
+		goto __73
+	case 72:
+		goto __74
+	case 73:
+		goto __75
+	case 74:
+		goto __76
+	case 75:
+		goto __77
+	case 76:
+		goto __78
+	case 77:
+		goto __79
+	case 78:
+		goto __80
+	case 79:
+		goto __81
+	case 80:
+		goto __82
+	case 81:
+		goto __83
+	case 82:
+		goto __84
+	case 83:
+		goto __85
+	case 84:
+		goto __86
+	case 85:
+		goto __87
+	case 86:
+		goto __88
+	case 87:
+		goto __89
+	case 88:
+		goto __90
+	case 89:
+		goto __91
+	case 90:
+		goto __92
+	case 91:
+		goto __93
+	case 92:
+		goto __94
+	case 93:
+		goto __95
+	case 94:
+		goto __96
+	case 95:
+		goto __97
+	case 96:
+		goto __98
+	case 97:
+		goto __99
+	case 98:
+		goto __100
+	case 99:
+		goto __101
+	case 100:
+		goto __102
+	case 101:
+		goto __103
+	case 102:
+		goto __104
+	case 103:
+		goto __105
+	case 104:
+		goto __106
+	case 105:
+		goto __107
+	case 106:
+		goto __108
+	case 107:
+		goto __109
+	case 108:
+		goto __110
+	case 109:
+		goto __111
+	case 110:
+		goto __112
+	case 111:
+		goto __113
+	case 112:
+		goto __114
+	case 113:
+		goto __115
+	case 114:
+		goto __116
+	case 115:
+		goto __117
+	case 116:
+		goto __118
+	case 117:
+		goto __119
+	case 118:
+		goto __120
+	case 119:
+		goto __121
+	case 120:
+		goto __122
+	case 121:
+		goto __123
+	case 122:
+		goto __124
+	case 123:
+		goto __125
+	case 124:
+		goto __126
+	case 125:
+		goto __127
+	case 126:
+		goto __128
+	case 127:
+		goto __129
+	case 128:
+		goto __130
+	case 129:
+		goto __131
+	case 130:
+		goto __132
+	case 131:
+		goto __133
+	case 132:
+		goto __134
+	case 133:
+		goto __135
+	case 134:
+		goto __136
+	case 135:
+		goto __137
+	case 136:
+		goto __138
+	case 137:
+		goto __139
+	case 138:
+		goto __140
+	case 139:
+		goto __141
+	case 140:
+		goto __142
+	case 141:
+		goto __143
+	case 142:
+		goto __144
+	case 143:
+		goto __145
+	case 144:
+		goto __146
+	case 145:
+		goto __147
+	case 146:
+		goto __148
+	case 147:
+		goto __149
+	case 148:
+		goto __150
+	case 149:
+		goto __151
+	case 150:
+		goto __152
+	case 151:
+		goto __153
+	case 152:
+		goto __154
+	case 153:
+		goto __155
+	case 154:
+		goto __156
+	case 155:
+		goto __157
+	case 156:
+		goto __158
+	case 157:
+		goto __159
+	case 158:
+		goto __160
+	case 159:
+		goto __161
+	case 160:
+		goto __162
+	case 161:
+		goto __163
+	case 162:
+		goto __164
+	case 163:
+		goto __165
+	default:
+		goto __166
+	}
+	goto __1
+__2:
+	if !(eof != 0) {
+		goto __167
+	}
+	state = TSStateId(77)
+	goto next_state
+__167:
+	;
+
+	if !(lookahead == '\n') {
+		goto __168
+	}
+	state = TSStateId(129)
+	goto next_state
+__168:
+	;
+
+	if !(lookahead == '\r') {
+		goto __169
+	}
+	state = TSStateId(1)
+	goto next_state
+__169:
+	;
+
+	if !(lookahead == '"') {
+		goto __170
+	}
+	state = TSStateId(127)
+	goto next_state
+__170:
+	;
+
+	if !(lookahead == '#') {
+		goto __171
+	}
+	state = TSStateId(79)
+	goto next_state
+__171:
+	;
+
+	if !(lookahead == '\'') {
+		goto __172
+	}
+	state = TSStateId(137)
+	goto next_state
+__172:
+	;
+
+	if !(lookahead == '+') {
+		goto __173
+	}
+	state = TSStateId(15)
+	goto next_state
+__173:
+	;
+
+	if !(lookahead == ',') {
+		goto __174
+	}
+	state = TSStateId(161)
+	goto next_state
+__174:
+	;
+
+	if !(lookahead == '-') {
+		goto __175
+	}
+	state = TSStateId(95)
+	goto next_state
+__175:
+	;
+
+	if !(lookahead == '.') {
+		goto __176
+	}
+	state = TSStateId(86)
+	goto next_state
+__176:
+	;
+
+	if !(lookahead == '0') {
+		goto __177
+	}
+	state = TSStateId(92)
+	goto next_state
+__177:
+	;
+
+	if !(lookahead == '1') {
+		goto __178
+	}
+	state = TSStateId(90)
+	goto next_state
+__178:
+	;
+
+	if !(lookahead == '2') {
+		goto __179
+	}
+	state = TSStateId(89)
+	goto next_state
+__179:
+	;
+
+	if !(lookahead == '=') {
+		goto __180
+	}
+	state = TSStateId(85)
+	goto next_state
+__180:
+	;
+
+	if !(lookahead == '[') {
+		goto __181
+	}
+	state = TSStateId(81)
+	goto next_state
+__181:
+	;
+
+	if !(lookahead == '\\') {
+		goto __182
+	}
+	state = TSStateId(5)
+	goto next_state
+__182:
+	;
+
+	if !(lookahead == ']') {
+		goto __183
+	}
+	state = TSStateId(82)
+	goto next_state
+__183:
+	;
+
+	if !(lookahead == 'f') {
+		goto __184
+	}
+	state = TSStateId(102)
+	goto next_state
+__184:
+	;
+
+	if !(lookahead == 'i') {
+		goto __185
+	}
+	state = TSStateId(108)
+	goto next_state
+__185:
+	;
+
+	if !(lookahead == 'n') {
+		goto __186
+	}
+	state = TSStateId(103)
+	goto next_state
+__186:
+	;
+
+	if !(lookahead == 't') {
+		goto __187
+	}
+	state = TSStateId(109)
+	goto next_state
+__187:
+	;
+
+	if !(lookahead == '{') {
+		goto __188
+	}
+	state = TSStateId(162)
+	goto next_state
+__188:
+	;
+
+	if !(lookahead == '}') {
+		goto __189
+	}
+	state = TSStateId(163)
+	goto next_state
+__189:
+	;
+
+	if !(lookahead == '\t' || lookahead == ' ') {
+		goto __190
+	}
+	skip = uint8(True)
+	state = TSStateId(75)
+	goto next_state
+__190:
+	;
+	if !('3' <= lookahead && lookahead <= '9') {
+		goto __191
+	}
+	state = TSStateId(91)
+	goto next_state
+__191:
+	;
+
+	if !('A' <= lookahead && lookahead <= 'Z' || lookahead == '_' || 'a' <= lookahead && lookahead <= 'z') {
+		goto __192
+	}
+	state = TSStateId(121)
+	goto next_state
+__192:
+	;
+
+	return result
+
+__3:
+	if !(lookahead == '\n') {
+		goto __193
+	}
+	state = TSStateId(129)
+	goto next_state
+__193:
+	;
+
+	return result
+
+__4:
+	if !(lookahead == '\n') {
+		goto __194
+	}
+	state = TSStateId(129)
+	goto next_state
+__194:
+	;
+
+	if !(lookahead == '\r') {
+		goto __195
+	}
+	state = TSStateId(1)
+	goto next_state
+__195:
+	;
+
+	if !(lookahead == '"') {
+		goto __196
+	}
+	state = TSStateId(126)
+	goto next_state
+__196:
+	;
+
+	if !(lookahead == '#') {
+		goto __197
+	}
+	state = TSStateId(125)
+	goto next_state
+__197:
+	;
+
+	if !(lookahead == '\\') {
+		goto __198
+	}
+	state = TSStateId(5)
+	goto next_state
+__198:
+	;
+
+	if !(lookahead == '\t' || lookahead == ' ') {
+		goto __199
+	}
+	state = TSStateId(124)
+	goto next_state
+__199:
+	;
+
+	if !(lookahead != 0 && lookahead > 31 && lookahead != 127) {
+		goto __200
+	}
+	state = TSStateId(125)
+	goto next_state
+__200:
+	;
+
+	return result
+
+__5:
+	if !(lookahead == '\n') {
+		goto __201
+	}
+	state = TSStateId(129)
+	goto next_state
+__201:
+	;
+
+	if !(lookahead == '\r') {
+		goto __202
+	}
+	state = TSStateId(1)
+	goto next_state
+__202:
+	;
+
+	if !(lookahead == '#') {
+		goto __203
+	}
+	state = TSStateId(135)
+	goto next_state
+__203:
+	;
+
+	if !(lookahead == '\'') {
+		goto __204
+	}
+	state = TSStateId(136)
+	goto next_state
+__204:
+	;
+
+	if !(lookahead == '\t' || lookahead == ' ') {
+		goto __205
+	}
+	state = TSStateId(134)
+	goto next_state
+__205:
+	;
+
+	if !(lookahead != 0 && lookahead > 31 && lookahead != 127) {
+		goto __206
+	}
+	state = TSStateId(135)
+	goto next_state
+__206:
+	;
+
+	return result
+
+__6:
+	if !(lookahead == '\n') {
+		goto __207
+	}
+	state = TSStateId(131)
+	goto next_state
+__207:
+	;
+
+	return result
+
+__7:
+	if !(lookahead == '\n') {
+		goto __208
+	}
+	state = TSStateId(131)
+	goto next_state
+__208:
+	;
+
+	if !(lookahead == '\r') {
+		goto __209
+	}
+	state = TSStateId(4)
+	goto next_state
+__209:
+	;
+
+	if !(lookahead == 'U') {
+		goto __210
+	}
+	state = TSStateId(74)
+	goto next_state
+__210:
+	;
+
+	if !(lookahead == 'u') {
+		goto __211
+	}
+	state = TSStateId(70)
+	goto next_state
+__211:
+	;
+
+	if !(lookahead == '"' || lookahead == '\\' || lookahead == 'b' || lookahead == 'f' || lookahead == 'n' || lookahead == 'r' || lookahead == 't') {
+		goto __212
+	}
+	state = TSStateId(130)
+	goto next_state
+__212:
+	;
+
+	return result
+
+__8:
+	if !(lookahead == '\n') {
+		goto __213
+	}
+	state = TSStateId(78)
+	goto next_state
+__213:
+	;
+
+	return result
+
+__9:
+	if !(lookahead == '\n') {
+		goto __214
+	}
+	state = TSStateId(78)
+	goto next_state
+__214:
+	;
+
+	if !(lookahead == '\r') {
+		goto __215
+	}
+	state = TSStateId(6)
+	goto next_state
+__215:
+	;
+
+	if !(lookahead == '"') {
+		goto __216
+	}
+	state = TSStateId(123)
+	goto next_state
+__216:
+	;
+
+	if !(lookahead == '#') {
+		goto __217
+	}
+	state = TSStateId(79)
+	goto next_state
+__217:
+	;
+
+	if !(lookahead == '\'') {
+		goto __218
+	}
+	state = TSStateId(133)
+	goto next_state
+__218:
+	;
+
+	if !(lookahead == ',') {
+		goto __219
+	}
+	state = TSStateId(161)
+	goto next_state
+__219:
+	;
+
+	if !(lookahead == '0') {
+		goto __220
+	}
+	state = TSStateId(144)
+	goto next_state
+__220:
+	;
+
+	if !(lookahead == '1') {
+		goto __221
+	}
+	state = TSStateId(142)
+	goto next_state
+__221:
+	;
+
+	if !(lookahead == '2') {
+		goto __222
+	}
+	state = TSStateId(141)
+	goto next_state
+__222:
+	;
+
+	if !(lookahead == '[') {
+		goto __223
+	}
+	state = TSStateId(80)
+	goto next_state
+__223:
+	;
+
+	if !(lookahead == ']') {
+		goto __224
+	}
+	state = TSStateId(82)
+	goto next_state
+__224:
+	;
+
+	if !(lookahead == 'f') {
+		goto __225
+	}
+	state = TSStateId(30)
+	goto next_state
+__225:
+	;
+
+	if !(lookahead == 'i') {
+		goto __226
+	}
+	state = TSStateId(35)
+	goto next_state
+__226:
+	;
+
+	if !(lookahead == 'n') {
+		goto __227
+	}
+	state = TSStateId(31)
+	goto next_state
+__227:
+	;
+
+	if !(lookahead == 't') {
+		goto __228
+	}
+	state = TSStateId(37)
+	goto next_state
+__228:
+	;
+
+	if !(lookahead == '{') {
+		goto __229
+	}
+	state = TSStateId(162)
+	goto next_state
+__229:
+	;
+
+	if !(lookahead == '\t' || lookahead == ' ') {
+		goto __230
+	}
+	skip = uint8(True)
+	state = TSStateId(7)
+	goto next_state
+__230:
+	;
+	if !('+' <= lookahead && lookahead <= '-') {
+		goto __231
+	}
+	state = TSStateId(17)
+	goto next_state
+__231:
+	;
+
+	if !('3' <= lookahead && lookahead <= '9') {
+		goto __232
+	}
+	state = TSStateId(143)
+	goto next_state
+__232:
+	;
+
+	return result
+
+__10:
+	if !(lookahead == '"') {
+		goto __233
+	}
+	state = TSStateId(128)
+	goto next_state
+__233:
+	;
+
+	return result
+
+__11:
+	if !(lookahead == '#') {
+		goto __234
+	}
+	state = TSStateId(79)
+	goto next_state
+__234:
+	;
+
+	if !(lookahead == '\'') {
+		goto __235
+	}
+	state = TSStateId(136)
+	goto next_state
+__235:
+	;
+
+	if !(lookahead == '.') {
+		goto __236
+	}
+	state = TSStateId(86)
+	goto next_state
+__236:
+	;
+
+	if !(lookahead == ']') {
+		goto __237
+	}
+	state = TSStateId(29)
+	goto next_state
+__237:
+	;
+
+	if !(lookahead == '\t' || lookahead == ' ') {
+		goto __238
+	}
+	skip = uint8(True)
+	state = TSStateId(10)
+	goto next_state
+__238:
+	;
+	return result
+
+__12:
+	if !(lookahead == '#') {
+		goto __239
+	}
+	state = TSStateId(79)
+	goto next_state
+__239:
+	;
+
+	if !(lookahead == '.') {
+		goto __240
+	}
+	state = TSStateId(86)
+	goto next_state
+__240:
+	;
+
+	if !(lookahead == ']') {
+		goto __241
+	}
+	state = TSStateId(29)
+	goto next_state
+__241:
+	;
+
+	if !(lookahead == '\t' || lookahead == ' ') {
+		goto __242
+	}
+	skip = uint8(True)
+	state = TSStateId(10)
+	goto next_state
+__242:
+	;
+	return result
+
+__13:
+	if !(lookahead == '\'') {
+		goto __243
+	}
+	state = TSStateId(138)
+	goto next_state
+__243:
+	;
+
+	return result
+
+__14:
+	if !(lookahead == '-') {
+		goto __244
+	}
+	state = TSStateId(18)
+	goto next_state
+__244:
+	;
+
+	if !(lookahead == ':') {
+		goto __245
+	}
+	state = TSStateId(46)
+	goto next_state
+__245:
+	;
+
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __246
+	}
+	state = TSStateId(13)
+	goto next_state
+__246:
+	;
+
+	return result
+
+__15:
+	if !(lookahead == '-') {
+		goto __247
+	}
+	state = TSStateId(18)
+	goto next_state
+__247:
+	;
+
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __248
+	}
+	state = TSStateId(13)
+	goto next_state
+__248:
+	;
+
+	return result
+
+__16:
+	if !(lookahead == '-') {
+		goto __249
+	}
+	state = TSStateId(20)
+	goto next_state
+__249:
+	;
+
+	return result
+
+__17:
+	if !(lookahead == '0') {
+		goto __250
+	}
+	state = TSStateId(139)
+	goto next_state
+__250:
+	;
+
+	if !(lookahead == 'i') {
+		goto __251
+	}
+	state = TSStateId(35)
+	goto next_state
+__251:
+	;
+
+	if !(lookahead == 'n') {
+		goto __252
+	}
+	state = TSStateId(31)
+	goto next_state
+__252:
+	;
+
+	if !('1' <= lookahead && lookahead <= '9') {
+		goto __253
+	}
+	state = TSStateId(147)
+	goto next_state
+__253:
+	;
+
+	return result
+
+__18:
+	if !(lookahead == '0') {
+		goto __254
+	}
+	state = TSStateId(159)
+	goto next_state
+__254:
+	;
+
+	return result
+
+__19:
+	if !(lookahead == '0') {
+		goto __255
+	}
+	state = TSStateId(146)
+	goto next_state
+__255:
+	;
+
+	if !(lookahead == 'i') {
+		goto __256
+	}
+	state = TSStateId(35)
+	goto next_state
+__256:
+	;
+
+	if !(lookahead == 'n') {
+		goto __257
+	}
+	state = TSStateId(31)
+	goto next_state
+__257:
+	;
+
+	if !('1' <= lookahead && lookahead <= '9') {
+		goto __258
+	}
+	state = TSStateId(145)
+	goto next_state
+__258:
+	;
+
+	return result
+
+__20:
+	if !(lookahead == '0') {
+		goto __259
+	}
+	state = TSStateId(50)
+	goto next_state
+__259:
+	;
+
+	if !(lookahead == '1') {
+		goto __260
+	}
+	state = TSStateId(43)
+	goto next_state
+__260:
+	;
+
+	return result
+
+__21:
+	if !(lookahead == '0') {
+		goto __261
+	}
+	state = TSStateId(156)
+	goto next_state
+__261:
+	;
+
+	return result
+
+__22:
+	if !(lookahead == '0') {
+		goto __262
+	}
+	state = TSStateId(51)
+	goto next_state
+__262:
+	;
+
+	if !(lookahead == '3') {
+		goto __263
+	}
+	state = TSStateId(42)
+	goto next_state
+__263:
+	;
+
+	if !(lookahead == '1' || lookahead == '2') {
+		goto __264
+	}
+	state = TSStateId(59)
+	goto next_state
+__264:
+	;
+
+	return result
+
+__23:
+	if !(lookahead == '2') {
+		goto __265
+	}
+	state = TSStateId(44)
+	goto next_state
+__265:
+	;
+
+	if !(lookahead == '0' || lookahead == '1') {
+		goto __266
+	}
+	state = TSStateId(63)
+	goto next_state
+__266:
+	;
+
+	return result
+
+__24:
+	if !(lookahead == '2') {
+		goto __267
+	}
+	state = TSStateId(45)
+	goto next_state
+__267:
+	;
+
+	if !(lookahead == '0' || lookahead == '1') {
+		goto __268
+	}
+	state = TSStateId(64)
+	goto next_state
+__268:
+	;
+
+	return result
+
+__25:
+	if !(lookahead == '6') {
+		goto __269
+	}
+	state = TSStateId(16)
+	goto next_state
+__269:
+	;
+
+	if !('0' <= lookahead && lookahead <= '5') {
+		goto __270
+	}
+	state = TSStateId(54)
+	goto next_state
+__270:
+	;
+
+	return result
+
+__26:
+	if !(lookahead == '6') {
+		goto __271
+	}
+	state = TSStateId(19)
+	goto next_state
+__271:
+	;
+
+	if !('0' <= lookahead && lookahead <= '5') {
+		goto __272
+	}
+	state = TSStateId(60)
+	goto next_state
+__272:
+	;
+
+	return result
+
+__27:
+	if !(lookahead == ':') {
+		goto __273
+	}
+	state = TSStateId(23)
+	goto next_state
+__273:
+	;
+
+	return result
+
+__28:
+	if !(lookahead == ':') {
+		goto __274
+	}
+	state = TSStateId(47)
+	goto next_state
+__274:
+	;
+
+	return result
+
+__29:
+	if !(lookahead == ':') {
+		goto __275
+	}
+	state = TSStateId(24)
+	goto next_state
+__275:
+	;
+
+	return result
+
+__30:
+	if !(lookahead == ':') {
+		goto __276
+	}
+	state = TSStateId(48)
+	goto next_state
+__276:
+	;
+
+	return result
+
+__31:
+	if !(lookahead == ']') {
+		goto __277
+	}
+	state = TSStateId(84)
+	goto next_state
+__277:
+	;
+
+	return result
+
+__32:
+	if !(lookahead == 'a') {
+		goto __278
+	}
+	state = TSStateId(34)
+	goto next_state
+__278:
+	;
+
+	return result
+
+__33:
+	if !(lookahead == 'a') {
+		goto __279
+	}
+	state = TSStateId(36)
+	goto next_state
+__279:
+	;
+
+	return result
+
+__34:
+	if !(lookahead == 'e') {
+		goto __280
+	}
+	state = TSStateId(154)
+	goto next_state
+__280:
+	;
+
+	return result
+
+__35:
+	if !(lookahead == 'f') {
+		goto __281
+	}
+	state = TSStateId(153)
+	goto next_state
+__281:
+	;
+
+	return result
+
+__36:
+	if !(lookahead == 'l') {
+		goto __282
+	}
+	state = TSStateId(38)
+	goto next_state
+__282:
+	;
+
+	return result
+
+__37:
+	if !(lookahead == 'n') {
+		goto __283
+	}
+	state = TSStateId(33)
+	goto next_state
+__283:
+	;
+
+	return result
+
+__38:
+	if !(lookahead == 'n') {
+		goto __284
+	}
+	state = TSStateId(153)
+	goto next_state
+__284:
+	;
+
+	return result
+
+__39:
+	if !(lookahead == 'r') {
+		goto __285
+	}
+	state = TSStateId(39)
+	goto next_state
+__285:
+	;
+
+	return result
+
+__40:
+	if !(lookahead == 's') {
+		goto __286
+	}
+	state = TSStateId(32)
+	goto next_state
+__286:
+	;
+
+	return result
+
+__41:
+	if !(lookahead == 'u') {
+		goto __287
+	}
+	state = TSStateId(32)
+	goto next_state
+__287:
+	;
+
+	return result
+
+__42:
+	if !(lookahead == '+' || lookahead == '-') {
+		goto __288
+	}
+	state = TSStateId(58)
+	goto next_state
+__288:
+	;
+
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __289
+	}
+	state = TSStateId(152)
+	goto next_state
+__289:
+	;
+
+	return result
+
+__43:
+	if !(lookahead == '0' || lookahead == '1') {
+		goto __290
+	}
+	state = TSStateId(150)
+	goto next_state
+__290:
+	;
+
+	return result
+
+__44:
+	if !(lookahead == '0' || lookahead == '1') {
+		goto __291
+	}
+	state = TSStateId(158)
+	goto next_state
+__291:
+	;
+
+	return result
+
+__45:
+	if !('0' <= lookahead && lookahead <= '2') {
+		goto __292
+	}
+	state = TSStateId(14)
+	goto next_state
+__292:
+	;
+
+	return result
+
+__46:
+	if !('0' <= lookahead && lookahead <= '3') {
+		goto __293
+	}
+	state = TSStateId(28)
+	goto next_state
+__293:
+	;
+
+	return result
+
+__47:
+	if !('0' <= lookahead && lookahead <= '3') {
+		goto __294
+	}
+	state = TSStateId(26)
+	goto next_state
+__294:
+	;
+
+	return result
+
+__48:
+	if !('0' <= lookahead && lookahead <= '5') {
+		goto __295
+	}
+	state = TSStateId(53)
+	goto next_state
+__295:
+	;
+
+	return result
+
+__49:
+	if !('0' <= lookahead && lookahead <= '5') {
+		goto __296
+	}
+	state = TSStateId(61)
+	goto next_state
+__296:
+	;
+
+	return result
+
+__50:
+	if !('0' <= lookahead && lookahead <= '5') {
+		goto __297
+	}
+	state = TSStateId(65)
+	goto next_state
+__297:
+	;
+
+	return result
+
+__51:
+	if !('0' <= lookahead && lookahead <= '7') {
+		goto __298
+	}
+	state = TSStateId(149)
+	goto next_state
+__298:
+	;
+
+	return result
+
+__52:
+	if !('1' <= lookahead && lookahead <= '9') {
+		goto __299
+	}
+	state = TSStateId(14)
+	goto next_state
+__299:
+	;
+
+	return result
+
+__53:
+	if !('1' <= lookahead && lookahead <= '9') {
+		goto __300
+	}
+	state = TSStateId(158)
+	goto next_state
+__300:
+	;
+
+	return result
+
+__54:
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __301
+	}
+	state = TSStateId(147)
+	goto next_state
+__301:
+	;
+
+	return result
+
+__55:
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __302
+	}
+	state = TSStateId(25)
+	goto next_state
+__302:
+	;
+
+	return result
+
+__56:
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __303
+	}
+	state = TSStateId(159)
+	goto next_state
+__303:
+	;
+
+	return result
+
+__57:
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __304
+	}
+	state = TSStateId(160)
+	goto next_state
+__304:
+	;
+
+	return result
+
+__58:
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __305
+	}
+	state = TSStateId(145)
+	goto next_state
+__305:
+	;
+
+	return result
+
+__59:
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __306
+	}
+	state = TSStateId(151)
+	goto next_state
+__306:
+	;
+
+	return result
+
+__60:
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __307
+	}
+	state = TSStateId(152)
+	goto next_state
+__307:
+	;
+
+	return result
+
+__61:
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __308
+	}
+	state = TSStateId(158)
+	goto next_state
+__308:
+	;
+
+	return result
+
+__62:
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __309
+	}
+	state = TSStateId(156)
+	goto next_state
+__309:
+	;
+
+	return result
+
+__63:
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __310
+	}
+	state = TSStateId(155)
+	goto next_state
+__310:
+	;
+
+	return result
+
+__64:
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __311
+	}
+	state = TSStateId(157)
+	goto next_state
+__311:
+	;
+
+	return result
+
+__65:
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __312
+	}
+	state = TSStateId(28)
+	goto next_state
+__312:
+	;
+
+	return result
+
+__66:
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __313
+	}
+	state = TSStateId(26)
+	goto next_state
+__313:
+	;
+
+	return result
+
+__67:
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __314
+	}
+	state = TSStateId(27)
+	goto next_state
+__314:
+	;
+
+	return result
+
+__68:
+	if !('0' <= lookahead && lookahead <= '9' || 'A' <= lookahead && lookahead <= 'F' || 'a' <= lookahead && lookahead <= 'f') {
+		goto __315
+	}
+	state = TSStateId(130)
+	goto next_state
+__315:
+	;
+
+	return result
+
+__69:
+	if !('0' <= lookahead && lookahead <= '9' || 'A' <= lookahead && lookahead <= 'F' || 'a' <= lookahead && lookahead <= 'f') {
+		goto __316
+	}
+	state = TSStateId(148)
+	goto next_state
+__316:
+	;
+
+	return result
+
+__70:
+	if !('0' <= lookahead && lookahead <= '9' || 'A' <= lookahead && lookahead <= 'F' || 'a' <= lookahead && lookahead <= 'f') {
+		goto __317
+	}
+	state = TSStateId(66)
+	goto next_state
+__317:
+	;
+
+	return result
+
+__71:
+	if !('0' <= lookahead && lookahead <= '9' || 'A' <= lookahead && lookahead <= 'F' || 'a' <= lookahead && lookahead <= 'f') {
+		goto __318
+	}
+	state = TSStateId(68)
+	goto next_state
+__318:
+	;
+
+	return result
+
+__72:
+	if !('0' <= lookahead && lookahead <= '9' || 'A' <= lookahead && lookahead <= 'F' || 'a' <= lookahead && lookahead <= 'f') {
+		goto __319
+	}
+	state = TSStateId(69)
+	goto next_state
+__319:
+	;
+
+	return result
+
+__73:
+	if !('0' <= lookahead && lookahead <= '9' || 'A' <= lookahead && lookahead <= 'F' || 'a' <= lookahead && lookahead <= 'f') {
+		goto __320
+	}
+	state = TSStateId(70)
+	goto next_state
+__320:
+	;
+
+	return result
+
+__74:
+	if !('0' <= lookahead && lookahead <= '9' || 'A' <= lookahead && lookahead <= 'F' || 'a' <= lookahead && lookahead <= 'f') {
+		goto __321
+	}
+	state = TSStateId(71)
+	goto next_state
+__321:
+	;
+
+	return result
+
+__75:
+	if !('0' <= lookahead && lookahead <= '9' || 'A' <= lookahead && lookahead <= 'F' || 'a' <= lookahead && lookahead <= 'f') {
+		goto __322
+	}
+	state = TSStateId(72)
+	goto next_state
+__322:
+	;
+
+	return result
+
+__76:
+	if !('0' <= lookahead && lookahead <= '9' || 'A' <= lookahead && lookahead <= 'F' || 'a' <= lookahead && lookahead <= 'f') {
+		goto __323
+	}
+	state = TSStateId(73)
+	goto next_state
+__323:
+	;
+
+	return result
+
+__77:
+	if !(eof != 0) {
+		goto __324
+	}
+	state = TSStateId(77)
+	goto next_state
+__324:
+	;
+
+	if !(lookahead == '\n') {
+		goto __325
+	}
+	state = TSStateId(78)
+	goto next_state
+__325:
+	;
+
+	if !(lookahead == '\r') {
+		goto __326
+	}
+	state = TSStateId(6)
+	goto next_state
+__326:
+	;
+
+	if !(lookahead == '"') {
+		goto __327
+	}
+	state = TSStateId(123)
+	goto next_state
+__327:
+	;
+
+	if !(lookahead == '#') {
+		goto __328
+	}
+	state = TSStateId(79)
+	goto next_state
+__328:
+	;
+
+	if !(lookahead == '\'') {
+		goto __329
+	}
+	state = TSStateId(133)
+	goto next_state
+__329:
+	;
+
+	if !(lookahead == '+') {
+		goto __330
+	}
+	state = TSStateId(15)
+	goto next_state
+__330:
+	;
+
+	if !(lookahead == ',') {
+		goto __331
+	}
+	state = TSStateId(161)
+	goto next_state
+__331:
+	;
+
+	if !(lookahead == '-') {
+		goto __332
+	}
+	state = TSStateId(95)
+	goto next_state
+__332:
+	;
+
+	if !(lookahead == '.') {
+		goto __333
+	}
+	state = TSStateId(86)
+	goto next_state
+__333:
+	;
+
+	if !(lookahead == '0') {
+		goto __334
+	}
+	state = TSStateId(92)
+	goto next_state
+__334:
+	;
+
+	if !(lookahead == '1') {
+		goto __335
+	}
+	state = TSStateId(90)
+	goto next_state
+__335:
+	;
+
+	if !(lookahead == '2') {
+		goto __336
+	}
+	state = TSStateId(89)
+	goto next_state
+__336:
+	;
+
+	if !(lookahead == '=') {
+		goto __337
+	}
+	state = TSStateId(85)
+	goto next_state
+__337:
+	;
+
+	if !(lookahead == '[') {
+		goto __338
+	}
+	state = TSStateId(81)
+	goto next_state
+__338:
+	;
+
+	if !(lookahead == ']') {
+		goto __339
+	}
+	state = TSStateId(82)
+	goto next_state
+__339:
+	;
+
+	if !(lookahead == 'f') {
+		goto __340
+	}
+	state = TSStateId(102)
+	goto next_state
+__340:
+	;
+
+	if !(lookahead == 'i') {
+		goto __341
+	}
+	state = TSStateId(108)
+	goto next_state
+__341:
+	;
+
+	if !(lookahead == 'n') {
+		goto __342
+	}
+	state = TSStateId(103)
+	goto next_state
+__342:
+	;
+
+	if !(lookahead == 't') {
+		goto __343
+	}
+	state = TSStateId(109)
+	goto next_state
+__343:
+	;
+
+	if !(lookahead == '{') {
+		goto __344
+	}
+	state = TSStateId(162)
+	goto next_state
+__344:
+	;
+
+	if !(lookahead == '}') {
+		goto __345
+	}
+	state = TSStateId(163)
+	goto next_state
+__345:
+	;
+
+	if !(lookahead == '\t' || lookahead == ' ') {
+		goto __346
+	}
+	skip = uint8(True)
+	state = TSStateId(75)
+	goto next_state
+__346:
+	;
+	if !('3' <= lookahead && lookahead <= '9') {
+		goto __347
+	}
+	state = TSStateId(91)
+	goto next_state
+__347:
+	;
+
+	if !('A' <= lookahead && lookahead <= 'Z' || lookahead == '_' || 'a' <= lookahead && lookahead <= 'z') {
+		goto __348
+	}
+	state = TSStateId(121)
+	goto next_state
+__348:
+	;
+
+	return result
+
+__78:
+	if !(eof != 0) {
+		goto __349
+	}
+	state = TSStateId(77)
+	goto next_state
+__349:
+	;
+
+	if !(lookahead == '\n') {
+		goto __350
+	}
+	state = TSStateId(78)
+	goto next_state
+__350:
+	;
+
+	if !(lookahead == '\r') {
+		goto __351
+	}
+	state = TSStateId(6)
+	goto next_state
+__351:
+	;
+
+	if !(lookahead == '"') {
+		goto __352
+	}
+	state = TSStateId(122)
+	goto next_state
+__352:
+	;
+
+	if !(lookahead == '#') {
+		goto __353
+	}
+	state = TSStateId(79)
+	goto next_state
+__353:
+	;
+
+	if !(lookahead == '\'') {
+		goto __354
+	}
+	state = TSStateId(132)
+	goto next_state
+__354:
+	;
+
+	if !(lookahead == ',') {
+		goto __355
+	}
+	state = TSStateId(161)
+	goto next_state
+__355:
+	;
+
+	if !(lookahead == '.') {
+		goto __356
+	}
+	state = TSStateId(86)
+	goto next_state
+__356:
+	;
+
+	if !(lookahead == '=') {
+		goto __357
+	}
+	state = TSStateId(85)
+	goto next_state
+__357:
+	;
+
+	if !(lookahead == '[') {
+		goto __358
+	}
+	state = TSStateId(81)
+	goto next_state
+__358:
+	;
+
+	if !(lookahead == ']') {
+		goto __359
+	}
+	state = TSStateId(82)
+	goto next_state
+__359:
+	;
+
+	if !(lookahead == '}') {
+		goto __360
+	}
+	state = TSStateId(163)
+	goto next_state
+__360:
+	;
+
+	if !(lookahead == '\t' || lookahead == ' ') {
+		goto __361
+	}
+	skip = uint8(True)
+	state = TSStateId(76)
+	goto next_state
+__361:
+	;
+	if !(lookahead == '-' || '0' <= lookahead && lookahead <= '9' || 'A' <= lookahead && lookahead <= 'Z' || lookahead == '_' || 'a' <= lookahead && lookahead <= 'z') {
+		goto __362
+	}
+	state = TSStateId(121)
+	goto next_state
+__362:
+	;
+
+	return result
+
+__79:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = TSSymbol(Ts_builtin_sym_end)
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	return result
+
+__80:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Aux_sym_document_token1
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	return result
+
+__81:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_comment
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead != 0 && lookahead > 8 && (lookahead < '\n' || 31 < lookahead) && lookahead != 127) {
+		goto __363
+	}
+	state = TSStateId(79)
+	goto next_state
+__363:
+	;
+
+	return result
+
+__82:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Anon_sym_LBRACK
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	return result
+
+__83:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Anon_sym_LBRACK
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '[') {
+		goto __364
+	}
+	state = TSStateId(83)
+	goto next_state
+__364:
+	;
+
+	return result
+
+__84:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Anon_sym_RBRACK
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	return result
+
+__85:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Anon_sym_LBRACK_LBRACK
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	return result
+
+__86:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Anon_sym_RBRACK_RBRACK
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	return result
+
+__87:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Anon_sym_EQ
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	return result
+
+__88:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Anon_sym_DOT
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	return result
+
+__89:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '-') {
+		goto __365
+	}
+	state = TSStateId(96)
+	goto next_state
+__365:
+	;
+
+	if !(lookahead == ':') {
+		goto __366
+	}
+	state = TSStateId(46)
+	goto next_state
+__366:
+	;
+
+	if !(lookahead == '_') {
+		goto __367
+	}
+	state = TSStateId(119)
+	goto next_state
+__367:
+	;
+
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __368
+	}
+	state = TSStateId(91)
+	goto next_state
+__368:
+	;
+
+	if !('A' <= lookahead && lookahead <= 'Z' || 'a' <= lookahead && lookahead <= 'z') {
+		goto __369
+	}
+	state = TSStateId(121)
+	goto next_state
+__369:
+	;
+
+	return result
+
+__90:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '-') {
+		goto __370
+	}
+	state = TSStateId(96)
+	goto next_state
+__370:
+	;
+
+	if !(lookahead == ':') {
+		goto __371
+	}
+	state = TSStateId(46)
+	goto next_state
+__371:
+	;
+
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __372
+	}
+	state = TSStateId(93)
+	goto next_state
+__372:
+	;
+
+	if !('A' <= lookahead && lookahead <= 'Z' || lookahead == '_' || 'a' <= lookahead && lookahead <= 'z') {
+		goto __373
+	}
+	state = TSStateId(121)
+	goto next_state
+__373:
+	;
+
+	return result
+
+__91:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '-') {
+		goto __374
+	}
+	state = TSStateId(96)
+	goto next_state
+__374:
+	;
+
+	if !(lookahead == '_') {
+		goto __375
+	}
+	state = TSStateId(119)
+	goto next_state
+__375:
+	;
+
+	if !('0' <= lookahead && lookahead <= '3') {
+		goto __376
+	}
+	state = TSStateId(87)
+	goto next_state
+__376:
+	;
+
+	if !('4' <= lookahead && lookahead <= '9') {
+		goto __377
+	}
+	state = TSStateId(91)
+	goto next_state
+__377:
+	;
+
+	if !('A' <= lookahead && lookahead <= 'Z' || 'a' <= lookahead && lookahead <= 'z') {
+		goto __378
+	}
+	state = TSStateId(121)
+	goto next_state
+__378:
+	;
+
+	return result
+
+__92:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '-') {
+		goto __379
+	}
+	state = TSStateId(96)
+	goto next_state
+__379:
+	;
+
+	if !(lookahead == '_') {
+		goto __380
+	}
+	state = TSStateId(119)
+	goto next_state
+__380:
+	;
+
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __381
+	}
+	state = TSStateId(87)
+	goto next_state
+__381:
+	;
+
+	if !('A' <= lookahead && lookahead <= 'Z' || 'a' <= lookahead && lookahead <= 'z') {
+		goto __382
+	}
+	state = TSStateId(121)
+	goto next_state
+__382:
+	;
+
+	return result
+
+__93:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '-') {
+		goto __383
+	}
+	state = TSStateId(96)
+	goto next_state
+__383:
+	;
+
+	if !(lookahead == '_') {
+		goto __384
+	}
+	state = TSStateId(119)
+	goto next_state
+__384:
+	;
+
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __385
+	}
+	state = TSStateId(91)
+	goto next_state
+__385:
+	;
+
+	if !('A' <= lookahead && lookahead <= 'Z' || 'a' <= lookahead && lookahead <= 'z') {
+		goto __386
+	}
+	state = TSStateId(121)
+	goto next_state
+__386:
+	;
+
+	return result
+
+__94:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '-') {
+		goto __387
+	}
+	state = TSStateId(96)
+	goto next_state
+__387:
+	;
+
+	if !(lookahead == 'b') {
+		goto __388
+	}
+	state = TSStateId(113)
+	goto next_state
+__388:
+	;
+
+	if !(lookahead == 'o') {
+		goto __389
+	}
+	state = TSStateId(115)
+	goto next_state
+__389:
+	;
+
+	if !(lookahead == 'x') {
+		goto __390
+	}
+	state = TSStateId(120)
+	goto next_state
+__390:
+	;
+
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __391
+	}
+	state = TSStateId(88)
+	goto next_state
+__391:
+	;
+
+	if !('A' <= lookahead && lookahead <= 'Z' || lookahead == '_' || 'a' <= lookahead && lookahead <= 'z') {
+		goto __392
+	}
+	state = TSStateId(121)
+	goto next_state
+__392:
+	;
+
+	return result
+
+__95:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '-') {
+		goto __393
+	}
+	state = TSStateId(96)
+	goto next_state
+__393:
+	;
+
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __394
+	}
+	state = TSStateId(93)
+	goto next_state
+__394:
+	;
+
+	if !('A' <= lookahead && lookahead <= 'Z' || lookahead == '_' || 'a' <= lookahead && lookahead <= 'z') {
+		goto __395
+	}
+	state = TSStateId(121)
+	goto next_state
+__395:
+	;
+
+	return result
+
+__96:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '-') {
+		goto __396
+	}
+	state = TSStateId(97)
+	goto next_state
+__396:
+	;
+
+	if !('0' <= lookahead && lookahead <= '9' || 'A' <= lookahead && lookahead <= 'Z' || lookahead == '_' || 'a' <= lookahead && lookahead <= 'z') {
+		goto __397
+	}
+	state = TSStateId(121)
+	goto next_state
+__397:
+	;
+
+	return result
+
+__97:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '0') {
+		goto __398
+	}
+	state = TSStateId(121)
+	goto next_state
+__398:
+	;
+
+	if !(lookahead == 'i') {
+		goto __399
+	}
+	state = TSStateId(108)
+	goto next_state
+__399:
+	;
+
+	if !(lookahead == 'n') {
+		goto __400
+	}
+	state = TSStateId(103)
+	goto next_state
+__400:
+	;
+
+	if !('1' <= lookahead && lookahead <= '9') {
+		goto __401
+	}
+	state = TSStateId(101)
+	goto next_state
+__401:
+	;
+
+	if !(lookahead == '-' || 'A' <= lookahead && lookahead <= 'Z' || lookahead == '_' || 'a' <= lookahead && lookahead <= 'z') {
+		goto __402
+	}
+	state = TSStateId(121)
+	goto next_state
+__402:
+	;
+
+	return result
+
+__98:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '0') {
+		goto __403
+	}
+	state = TSStateId(117)
+	goto next_state
+__403:
+	;
+
+	if !(lookahead == '1') {
+		goto __404
+	}
+	state = TSStateId(114)
+	goto next_state
+__404:
+	;
+
+	if !(lookahead == '-' || '2' <= lookahead && lookahead <= '9' || 'A' <= lookahead && lookahead <= 'Z' || lookahead == '_' || 'a' <= lookahead && lookahead <= 'z') {
+		goto __405
+	}
+	state = TSStateId(121)
+	goto next_state
+__405:
+	;
+
+	return result
+
+__99:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '0') {
+		goto __406
+	}
+	state = TSStateId(116)
+	goto next_state
+__406:
+	;
+
+	if !(lookahead == '3') {
+		goto __407
+	}
+	state = TSStateId(112)
+	goto next_state
+__407:
+	;
+
+	if !(lookahead == '1' || lookahead == '2') {
+		goto __408
+	}
+	state = TSStateId(118)
+	goto next_state
+__408:
+	;
+
+	if !(lookahead == '-' || '4' <= lookahead && lookahead <= '9' || 'A' <= lookahead && lookahead <= 'Z' || lookahead == '_' || 'a' <= lookahead && lookahead <= 'z') {
+		goto __409
+	}
+	state = TSStateId(121)
+	goto next_state
+__409:
+	;
+
+	return result
+
+__100:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '_') {
+		goto __410
+	}
+	state = TSStateId(113)
+	goto next_state
+__410:
+	;
+
+	if !(lookahead == '0' || lookahead == '1') {
+		goto __411
+	}
+	state = TSStateId(98)
+	goto next_state
+__411:
+	;
+
+	if !(lookahead == '-' || '2' <= lookahead && lookahead <= '9' || 'A' <= lookahead && lookahead <= 'Z' || 'a' <= lookahead && lookahead <= 'z') {
+		goto __412
+	}
+	state = TSStateId(121)
+	goto next_state
+__412:
+	;
+
+	return result
+
+__101:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '_') {
+		goto __413
+	}
+	state = TSStateId(115)
+	goto next_state
+__413:
+	;
+
+	if !('0' <= lookahead && lookahead <= '7') {
+		goto __414
+	}
+	state = TSStateId(99)
+	goto next_state
+__414:
+	;
+
+	if !(lookahead == '-' || lookahead == '8' || lookahead == '9' || 'A' <= lookahead && lookahead <= 'Z' || 'a' <= lookahead && lookahead <= 'z') {
+		goto __415
+	}
+	state = TSStateId(121)
+	goto next_state
+__415:
+	;
+
+	return result
+
+__102:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '_') {
+		goto __416
+	}
+	state = TSStateId(120)
+	goto next_state
+__416:
+	;
+
+	if !('0' <= lookahead && lookahead <= '9' || 'A' <= lookahead && lookahead <= 'F' || 'a' <= lookahead && lookahead <= 'f') {
+		goto __417
+	}
+	state = TSStateId(100)
+	goto next_state
+__417:
+	;
+
+	if !(lookahead == '-' || 'G' <= lookahead && lookahead <= 'Z' || 'g' <= lookahead && lookahead <= 'z') {
+		goto __418
+	}
+	state = TSStateId(121)
+	goto next_state
+__418:
+	;
+
+	return result
+
+__103:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '_') {
+		goto __419
+	}
+	state = TSStateId(119)
+	goto next_state
+__419:
+	;
+
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __420
+	}
+	state = TSStateId(101)
+	goto next_state
+__420:
+	;
+
+	if !(lookahead == '-' || 'A' <= lookahead && lookahead <= 'Z' || 'a' <= lookahead && lookahead <= 'z') {
+		goto __421
+	}
+	state = TSStateId(121)
+	goto next_state
+__421:
+	;
+
+	return result
+
+__104:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == 'a') {
+		goto __422
+	}
+	state = TSStateId(106)
+	goto next_state
+__422:
+	;
+
+	if !(lookahead == '-' || '0' <= lookahead && lookahead <= '9' || 'A' <= lookahead && lookahead <= 'Z' || lookahead == '_' || 'b' <= lookahead && lookahead <= 'z') {
+		goto __423
+	}
+	state = TSStateId(121)
+	goto next_state
+__423:
+	;
+
+	return result
+
+__105:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == 'a') {
+		goto __424
+	}
+	state = TSStateId(107)
+	goto next_state
+__424:
+	;
+
+	if !(lookahead == '-' || '0' <= lookahead && lookahead <= '9' || 'A' <= lookahead && lookahead <= 'Z' || lookahead == '_' || 'b' <= lookahead && lookahead <= 'z') {
+		goto __425
+	}
+	state = TSStateId(121)
+	goto next_state
+__425:
+	;
+
+	return result
+
+__106:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == 'e') {
+		goto __426
+	}
+	state = TSStateId(121)
+	goto next_state
+__426:
+	;
+
+	if !(lookahead == '-' || '0' <= lookahead && lookahead <= '9' || 'A' <= lookahead && lookahead <= 'Z' || lookahead == '_' || 'a' <= lookahead && lookahead <= 'z') {
+		goto __427
+	}
+	state = TSStateId(121)
+	goto next_state
+__427:
+	;
+
+	return result
+
+__107:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == 'f') {
+		goto __428
+	}
+	state = TSStateId(121)
+	goto next_state
+__428:
+	;
+
+	if !(lookahead == '-' || '0' <= lookahead && lookahead <= '9' || 'A' <= lookahead && lookahead <= 'Z' || lookahead == '_' || 'a' <= lookahead && lookahead <= 'z') {
+		goto __429
+	}
+	state = TSStateId(121)
+	goto next_state
+__429:
+	;
+
+	return result
+
+__108:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == 'l') {
+		goto __430
+	}
+	state = TSStateId(110)
+	goto next_state
+__430:
+	;
+
+	if !(lookahead == '-' || '0' <= lookahead && lookahead <= '9' || 'A' <= lookahead && lookahead <= 'Z' || lookahead == '_' || 'a' <= lookahead && lookahead <= 'z') {
+		goto __431
+	}
+	state = TSStateId(121)
+	goto next_state
+__431:
+	;
+
+	return result
+
+__109:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == 'n') {
+		goto __432
+	}
+	state = TSStateId(121)
+	goto next_state
+__432:
+	;
+
+	if !(lookahead == '-' || '0' <= lookahead && lookahead <= '9' || 'A' <= lookahead && lookahead <= 'Z' || lookahead == '_' || 'a' <= lookahead && lookahead <= 'z') {
+		goto __433
+	}
+	state = TSStateId(121)
+	goto next_state
+__433:
+	;
+
+	return result
+
+__110:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == 'n') {
+		goto __434
+	}
+	state = TSStateId(105)
+	goto next_state
+__434:
+	;
+
+	if !(lookahead == '-' || '0' <= lookahead && lookahead <= '9' || 'A' <= lookahead && lookahead <= 'Z' || lookahead == '_' || 'a' <= lookahead && lookahead <= 'z') {
+		goto __435
+	}
+	state = TSStateId(121)
+	goto next_state
+__435:
+	;
+
+	return result
+
+__111:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == 'r') {
+		goto __436
+	}
+	state = TSStateId(111)
+	goto next_state
+__436:
+	;
+
+	if !(lookahead == '-' || '0' <= lookahead && lookahead <= '9' || 'A' <= lookahead && lookahead <= 'Z' || lookahead == '_' || 'a' <= lookahead && lookahead <= 'z') {
+		goto __437
+	}
+	state = TSStateId(121)
+	goto next_state
+__437:
+	;
+
+	return result
+
+__112:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == 's') {
+		goto __438
+	}
+	state = TSStateId(104)
+	goto next_state
+__438:
+	;
+
+	if !(lookahead == '-' || '0' <= lookahead && lookahead <= '9' || 'A' <= lookahead && lookahead <= 'Z' || lookahead == '_' || 'a' <= lookahead && lookahead <= 'z') {
+		goto __439
+	}
+	state = TSStateId(121)
+	goto next_state
+__439:
+	;
+
+	return result
+
+__113:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == 'u') {
+		goto __440
+	}
+	state = TSStateId(104)
+	goto next_state
+__440:
+	;
+
+	if !(lookahead == '-' || '0' <= lookahead && lookahead <= '9' || 'A' <= lookahead && lookahead <= 'Z' || lookahead == '_' || 'a' <= lookahead && lookahead <= 'z') {
+		goto __441
+	}
+	state = TSStateId(121)
+	goto next_state
+__441:
+	;
+
+	return result
+
+__114:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '0' || lookahead == '1') {
+		goto __442
+	}
+	state = TSStateId(121)
+	goto next_state
+__442:
+	;
+
+	if !(lookahead == '-' || '2' <= lookahead && lookahead <= '9' || 'A' <= lookahead && lookahead <= 'Z' || lookahead == '_' || 'a' <= lookahead && lookahead <= 'z') {
+		goto __443
+	}
+	state = TSStateId(121)
+	goto next_state
+__443:
+	;
+
+	return result
+
+__115:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '0' || lookahead == '1') {
+		goto __444
+	}
+	state = TSStateId(98)
+	goto next_state
+__444:
+	;
+
+	if !(lookahead == '-' || '2' <= lookahead && lookahead <= '9' || 'A' <= lookahead && lookahead <= 'Z' || lookahead == '_' || 'a' <= lookahead && lookahead <= 'z') {
+		goto __445
+	}
+	state = TSStateId(121)
+	goto next_state
+__445:
+	;
+
+	return result
+
+__116:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !('0' <= lookahead && lookahead <= '2') {
+		goto __446
+	}
+	state = TSStateId(94)
+	goto next_state
+__446:
+	;
+
+	if !(lookahead == '-' || '3' <= lookahead && lookahead <= '9' || 'A' <= lookahead && lookahead <= 'Z' || lookahead == '_' || 'a' <= lookahead && lookahead <= 'z') {
+		goto __447
+	}
+	state = TSStateId(121)
+	goto next_state
+__447:
+	;
+
+	return result
+
+__117:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !('0' <= lookahead && lookahead <= '7') {
+		goto __448
+	}
+	state = TSStateId(99)
+	goto next_state
+__448:
+	;
+
+	if !(lookahead == '-' || lookahead == '8' || lookahead == '9' || 'A' <= lookahead && lookahead <= 'Z' || lookahead == '_' || 'a' <= lookahead && lookahead <= 'z') {
+		goto __449
+	}
+	state = TSStateId(121)
+	goto next_state
+__449:
+	;
+
+	return result
+
+__118:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !('1' <= lookahead && lookahead <= '9') {
+		goto __450
+	}
+	state = TSStateId(121)
+	goto next_state
+__450:
+	;
+
+	if !(lookahead == '-' || lookahead == '0' || 'A' <= lookahead && lookahead <= 'Z' || lookahead == '_' || 'a' <= lookahead && lookahead <= 'z') {
+		goto __451
+	}
+	state = TSStateId(121)
+	goto next_state
+__451:
+	;
+
+	return result
+
+__119:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !('1' <= lookahead && lookahead <= '9') {
+		goto __452
+	}
+	state = TSStateId(94)
+	goto next_state
+__452:
+	;
+
+	if !(lookahead == '-' || lookahead == '0' || 'A' <= lookahead && lookahead <= 'Z' || lookahead == '_' || 'a' <= lookahead && lookahead <= 'z') {
+		goto __453
+	}
+	state = TSStateId(121)
+	goto next_state
+__453:
+	;
+
+	return result
+
+__120:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __454
+	}
+	state = TSStateId(121)
+	goto next_state
+__454:
+	;
+
+	if !(lookahead == '-' || 'A' <= lookahead && lookahead <= 'Z' || lookahead == '_' || 'a' <= lookahead && lookahead <= 'z') {
+		goto __455
+	}
+	state = TSStateId(121)
+	goto next_state
+__455:
+	;
+
+	return result
+
+__121:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __456
+	}
+	state = TSStateId(101)
+	goto next_state
+__456:
+	;
+
+	if !(lookahead == '-' || 'A' <= lookahead && lookahead <= 'Z' || lookahead == '_' || 'a' <= lookahead && lookahead <= 'z') {
+		goto __457
+	}
+	state = TSStateId(121)
+	goto next_state
+__457:
+	;
+
+	return result
+
+__122:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !('0' <= lookahead && lookahead <= '9' || 'A' <= lookahead && lookahead <= 'F' || 'a' <= lookahead && lookahead <= 'f') {
+		goto __458
+	}
+	state = TSStateId(100)
+	goto next_state
+__458:
+	;
+
+	if !(lookahead == '-' || 'G' <= lookahead && lookahead <= 'Z' || lookahead == '_' || 'g' <= lookahead && lookahead <= 'z') {
+		goto __459
+	}
+	state = TSStateId(121)
+	goto next_state
+__459:
+	;
+
+	return result
+
+__123:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_bare_key
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '-' || '0' <= lookahead && lookahead <= '9' || 'A' <= lookahead && lookahead <= 'Z' || lookahead == '_' || 'a' <= lookahead && lookahead <= 'z') {
+		goto __460
+	}
+	state = TSStateId(121)
+	goto next_state
+__460:
+	;
+
+	return result
+
+__124:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Anon_sym_DQUOTE
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	return result
+
+__125:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Anon_sym_DQUOTE
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '"') {
+		goto __461
+	}
+	state = TSStateId(8)
+	goto next_state
+__461:
+	;
+
+	return result
+
+__126:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Aux_sym__basic_string_token1
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '#') {
+		goto __462
+	}
+	state = TSStateId(125)
+	goto next_state
+__462:
+	;
+
+	if !(lookahead == '\t' || lookahead == ' ') {
+		goto __463
+	}
+	state = TSStateId(124)
+	goto next_state
+__463:
+	;
+
+	if !(lookahead != 0 && lookahead > 31 && lookahead != '"' && lookahead != '\\' && lookahead != 127) {
+		goto __464
+	}
+	state = TSStateId(125)
+	goto next_state
+__464:
+	;
+
+	return result
+
+__127:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Aux_sym__basic_string_token1
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead != 0 && lookahead > 8 && (lookahead < '\n' || 31 < lookahead) && lookahead != '"' && lookahead != '\\' && lookahead != 127) {
+		goto __465
+	}
+	state = TSStateId(125)
+	goto next_state
+__465:
+	;
+
+	return result
+
+__128:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Anon_sym_DQUOTE2
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	return result
+
+__129:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Anon_sym_DQUOTE2
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '"') {
+		goto __466
+	}
+	state = TSStateId(8)
+	goto next_state
+__466:
+	;
+
+	return result
+
+__130:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Anon_sym_DQUOTE_DQUOTE_DQUOTE
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	return result
+
+__131:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Aux_sym__multiline_basic_string_token1
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	return result
+
+__132:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_escape_sequence
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	return result
+
+__133:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym__escape_line_ending
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	return result
+
+__134:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Anon_sym_SQUOTE
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	return result
+
+__135:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Anon_sym_SQUOTE
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '\'') {
+		goto __467
+	}
+	state = TSStateId(11)
+	goto next_state
+__467:
+	;
+
+	return result
+
+__136:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Aux_sym__literal_string_token1
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '#') {
+		goto __468
+	}
+	state = TSStateId(135)
+	goto next_state
+__468:
+	;
+
+	if !(lookahead == '\t' || lookahead == ' ') {
+		goto __469
+	}
+	state = TSStateId(134)
+	goto next_state
+__469:
+	;
+
+	if !(lookahead != 0 && lookahead > 31 && lookahead != '\'' && lookahead != 127) {
+		goto __470
+	}
+	state = TSStateId(135)
+	goto next_state
+__470:
+	;
+
+	return result
+
+__137:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Aux_sym__literal_string_token1
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead != 0 && lookahead > 8 && (lookahead < '\n' || 31 < lookahead) && lookahead != '\'' && lookahead != 127) {
+		goto __471
+	}
+	state = TSStateId(135)
+	goto next_state
+__471:
+	;
+
+	return result
+
+__138:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Anon_sym_SQUOTE2
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	return result
+
+__139:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Anon_sym_SQUOTE2
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '\'') {
+		goto __472
+	}
+	state = TSStateId(11)
+	goto next_state
+__472:
+	;
+
+	return result
+
+__140:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Anon_sym_SQUOTE_SQUOTE_SQUOTE
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	return result
+
+__141:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Aux_sym_integer_token1
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	return result
+
+__142:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Aux_sym_integer_token1
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '-') {
+		goto __473
+	}
+	state = TSStateId(18)
+	goto next_state
+__473:
+	;
+
+	if !(lookahead == '.') {
+		goto __474
+	}
+	state = TSStateId(57)
+	goto next_state
+__474:
+	;
+
+	if !(lookahead == ':') {
+		goto __475
+	}
+	state = TSStateId(46)
+	goto next_state
+__475:
+	;
+
+	if !(lookahead == '_') {
+		goto __476
+	}
+	state = TSStateId(56)
+	goto next_state
+__476:
+	;
+
+	if !(lookahead == 'E' || lookahead == 'e') {
+		goto __477
+	}
+	state = TSStateId(40)
+	goto next_state
+__477:
+	;
+
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __478
+	}
+	state = TSStateId(143)
+	goto next_state
+__478:
+	;
+
+	return result
+
+__143:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Aux_sym_integer_token1
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '-') {
+		goto __479
+	}
+	state = TSStateId(18)
+	goto next_state
+__479:
+	;
+
+	if !(lookahead == '.') {
+		goto __480
+	}
+	state = TSStateId(57)
+	goto next_state
+__480:
+	;
+
+	if !(lookahead == '_') {
+		goto __481
+	}
+	state = TSStateId(56)
+	goto next_state
+__481:
+	;
+
+	if !(lookahead == 'E' || lookahead == 'e') {
+		goto __482
+	}
+	state = TSStateId(40)
+	goto next_state
+__482:
+	;
+
+	if !('0' <= lookahead && lookahead <= '3') {
+		goto __483
+	}
+	state = TSStateId(140)
+	goto next_state
+__483:
+	;
+
+	if !('4' <= lookahead && lookahead <= '9') {
+		goto __484
+	}
+	state = TSStateId(143)
+	goto next_state
+__484:
+	;
+
+	return result
+
+__144:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Aux_sym_integer_token1
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '-') {
+		goto __485
+	}
+	state = TSStateId(18)
+	goto next_state
+__485:
+	;
+
+	if !(lookahead == '.') {
+		goto __486
+	}
+	state = TSStateId(57)
+	goto next_state
+__486:
+	;
+
+	if !(lookahead == '_') {
+		goto __487
+	}
+	state = TSStateId(56)
+	goto next_state
+__487:
+	;
+
+	if !(lookahead == 'E' || lookahead == 'e') {
+		goto __488
+	}
+	state = TSStateId(40)
+	goto next_state
+__488:
+	;
+
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __489
+	}
+	state = TSStateId(140)
+	goto next_state
+__489:
+	;
+
+	return result
+
+__145:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Aux_sym_integer_token1
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '-') {
+		goto __490
+	}
+	state = TSStateId(18)
+	goto next_state
+__490:
+	;
+
+	if !(lookahead == '.') {
+		goto __491
+	}
+	state = TSStateId(57)
+	goto next_state
+__491:
+	;
+
+	if !(lookahead == '_') {
+		goto __492
+	}
+	state = TSStateId(56)
+	goto next_state
+__492:
+	;
+
+	if !(lookahead == 'E' || lookahead == 'e') {
+		goto __493
+	}
+	state = TSStateId(40)
+	goto next_state
+__493:
+	;
+
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __494
+	}
+	state = TSStateId(143)
+	goto next_state
+__494:
+	;
+
+	return result
+
+__146:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Aux_sym_integer_token1
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '-') {
+		goto __495
+	}
+	state = TSStateId(18)
+	goto next_state
+__495:
+	;
+
+	if !(lookahead == '.') {
+		goto __496
+	}
+	state = TSStateId(57)
+	goto next_state
+__496:
+	;
+
+	if !(lookahead == 'b') {
+		goto __497
+	}
+	state = TSStateId(41)
+	goto next_state
+__497:
+	;
+
+	if !(lookahead == 'o') {
+		goto __498
+	}
+	state = TSStateId(49)
+	goto next_state
+__498:
+	;
+
+	if !(lookahead == 'x') {
+		goto __499
+	}
+	state = TSStateId(67)
+	goto next_state
+__499:
+	;
+
+	if !(lookahead == 'E' || lookahead == 'e') {
+		goto __500
+	}
+	state = TSStateId(40)
+	goto next_state
+__500:
+	;
+
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __501
+	}
+	state = TSStateId(12)
+	goto next_state
+__501:
+	;
+
+	return result
+
+__147:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Aux_sym_integer_token1
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '.') {
+		goto __502
+	}
+	state = TSStateId(57)
+	goto next_state
+__502:
+	;
+
+	if !(lookahead == '_') {
+		goto __503
+	}
+	state = TSStateId(56)
+	goto next_state
+__503:
+	;
+
+	if !(lookahead == 'E' || lookahead == 'e') {
+		goto __504
+	}
+	state = TSStateId(40)
+	goto next_state
+__504:
+	;
+
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __505
+	}
+	state = TSStateId(145)
+	goto next_state
+__505:
+	;
+
+	return result
+
+__148:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Aux_sym_integer_token1
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '.') {
+		goto __506
+	}
+	state = TSStateId(57)
+	goto next_state
+__506:
+	;
+
+	if !(lookahead == 'E' || lookahead == 'e') {
+		goto __507
+	}
+	state = TSStateId(40)
+	goto next_state
+__507:
+	;
+
+	return result
+
+__149:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Aux_sym_integer_token1
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '_') {
+		goto __508
+	}
+	state = TSStateId(52)
+	goto next_state
+__508:
+	;
+
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __509
+	}
+	state = TSStateId(147)
+	goto next_state
+__509:
+	;
+
+	return result
+
+__150:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Aux_sym_integer_token2
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '_') {
+		goto __510
+	}
+	state = TSStateId(67)
+	goto next_state
+__510:
+	;
+
+	if !('0' <= lookahead && lookahead <= '9' || 'A' <= lookahead && lookahead <= 'F' || 'a' <= lookahead && lookahead <= 'f') {
+		goto __511
+	}
+	state = TSStateId(148)
+	goto next_state
+__511:
+	;
+
+	return result
+
+__151:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Aux_sym_integer_token3
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '_') {
+		goto __512
+	}
+	state = TSStateId(49)
+	goto next_state
+__512:
+	;
+
+	if !('0' <= lookahead && lookahead <= '7') {
+		goto __513
+	}
+	state = TSStateId(149)
+	goto next_state
+__513:
+	;
+
+	return result
+
+__152:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Aux_sym_integer_token4
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '_') {
+		goto __514
+	}
+	state = TSStateId(41)
+	goto next_state
+__514:
+	;
+
+	if !(lookahead == '0' || lookahead == '1') {
+		goto __515
+	}
+	state = TSStateId(150)
+	goto next_state
+__515:
+	;
+
+	return result
+
+__153:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Aux_sym_float_token1
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '_') {
+		goto __516
+	}
+	state = TSStateId(57)
+	goto next_state
+__516:
+	;
+
+	if !(lookahead == 'E' || lookahead == 'e') {
+		goto __517
+	}
+	state = TSStateId(40)
+	goto next_state
+__517:
+	;
+
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __518
+	}
+	state = TSStateId(151)
+	goto next_state
+__518:
+	;
+
+	return result
+
+__154:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Aux_sym_float_token1
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '_') {
+		goto __519
+	}
+	state = TSStateId(58)
+	goto next_state
+__519:
+	;
+
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __520
+	}
+	state = TSStateId(152)
+	goto next_state
+__520:
+	;
+
+	return result
+
+__155:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Aux_sym_float_token2
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	return result
+
+__156:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_boolean
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	return result
+
+__157:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_offset_date_time
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	return result
+
+__158:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_local_date_time
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '.') {
+		goto __521
+	}
+	state = TSStateId(62)
+	goto next_state
+__521:
+	;
+
+	if !(lookahead == '+' || lookahead == '-') {
+		goto __522
+	}
+	state = TSStateId(22)
+	goto next_state
+__522:
+	;
+
+	if !(lookahead == 'Z' || lookahead == 'z') {
+		goto __523
+	}
+	state = TSStateId(155)
+	goto next_state
+__523:
+	;
+
+	return result
+
+__159:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_local_date_time
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '+' || lookahead == '-') {
+		goto __524
+	}
+	state = TSStateId(22)
+	goto next_state
+__524:
+	;
+
+	if !(lookahead == 'Z' || lookahead == 'z') {
+		goto __525
+	}
+	state = TSStateId(155)
+	goto next_state
+__525:
+	;
+
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __526
+	}
+	state = TSStateId(157)
+	goto next_state
+__526:
+	;
+
+	return result
+
+__160:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_local_date
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == ' ' || lookahead == 'T' || lookahead == 't') {
+		goto __527
+	}
+	state = TSStateId(21)
+	goto next_state
+__527:
+	;
+
+	return result
+
+__161:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_local_time
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !(lookahead == '.') {
+		goto __528
+	}
+	state = TSStateId(55)
+	goto next_state
+__528:
+	;
+
+	return result
+
+__162:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Sym_local_time
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if !('0' <= lookahead && lookahead <= '9') {
+		goto __529
+	}
+	state = TSStateId(160)
+	goto next_state
+__529:
+	;
+
+	return result
+
+__163:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Anon_sym_COMMA
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	return result
+
+__164:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Anon_sym_LBRACE
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	return result
+
+__165:
+	result = uint8(True)
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = Anon_sym_RBRACE
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	return result
+
+__166:
+	return uint8(False)
+__1:
+	;
+	return uint8(0)
+}
+
+var ts_lex_modes = [152]TSLexMode{{External_lex_state: Uint16_t(1)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(7)}, {Lex_state: Uint16_t(7)}, {Lex_state: Uint16_t(7)}, {Lex_state: Uint16_t(7)}, {Lex_state: Uint16_t(7)}, {Lex_state: Uint16_t(7)}, {Lex_state: Uint16_t(7)}, {Lex_state: Uint16_t(7)}, {Lex_state: Uint16_t(7)}, {Lex_state: Uint16_t(7)}, {Lex_state: Uint16_t(7)}, {Lex_state: Uint16_t(7)}, {Lex_state: Uint16_t(7)}, {Lex_state: Uint16_t(7)}, {Lex_state: Uint16_t(7)}, {Lex_state: Uint16_t(7)}, {Lex_state: Uint16_t(7)}, {Lex_state: Uint16_t(7)}, {Lex_state: Uint16_t(7)}, {Lex_state: Uint16_t(7)}, {Lex_state: Uint16_t(7)}, {Lex_state: Uint16_t(7)}, {Lex_state: Uint16_t(7)}, {Lex_state: Uint16_t(7)}, {Lex_state: Uint16_t(7)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(2), External_lex_state: Uint16_t(2)}, {Lex_state: Uint16_t(2), External_lex_state: Uint16_t(2)}, {Lex_state: Uint16_t(2), External_lex_state: Uint16_t(2)}, {Lex_state: Uint16_t(2), External_lex_state: Uint16_t(2)}, {Lex_state: Uint16_t(2), External_lex_state: Uint16_t(2)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {}, {}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(3), External_lex_state: Uint16_t(3)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(3), External_lex_state: Uint16_t(3)}, {Lex_state: Uint16_t(3), External_lex_state: Uint16_t(3)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(3), External_lex_state: Uint16_t(3)}, {Lex_state: Uint16_t(3), External_lex_state: Uint16_t(3)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(2)}, {Lex_state: Uint16_t(2)}, {Lex_state: Uint16_t(2)}, {Lex_state: Uint16_t(2)}, {Lex_state: Uint16_t(2)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(2)}, {Lex_state: Uint16_t(2)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {Lex_state: Uint16_t(76)}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {}, {Lex_state: Uint16_t(9)}, {}, {}, {Lex_state: Uint16_t(9)}, {}, {Lex_state: Uint16_t(3)}, {}, {Lex_state: Uint16_t(9)}, {Lex_state: Uint16_t(9)}, {Lex_state: Uint16_t(9)}, {Lex_state: Uint16_t(9)}, {Lex_state: Uint16_t(3)}, {Lex_state: Uint16_t(3)}, {}, {Lex_state: Uint16_t(9)}, {External_lex_state: Uint16_t(4)}, {External_lex_state: Uint16_t(4)}, {External_lex_state: Uint16_t(4)}, {External_lex_state: Uint16_t(4)}, {External_lex_state: Uint16_t(4)}, {External_lex_state: Uint16_t(4)}, {External_lex_state: Uint16_t(4)}, {External_lex_state: Uint16_t(4)}, {External_lex_state: Uint16_t(4)}, {External_lex_state: Uint16_t(4)}, {External_lex_state: Uint16_t(4)}, {External_lex_state: Uint16_t(4)}, {Lex_state: Uint16_t(9)}, {External_lex_state: Uint16_t(4)}, {External_lex_state: Uint16_t(4)}, {External_lex_state: Uint16_t(4)}, {External_lex_state: Uint16_t(4)}, {External_lex_state: Uint16_t(4)}, {External_lex_state: Uint16_t(4)}, {External_lex_state: Uint16_t(4)}, {External_lex_state: Uint16_t(4)}, {External_lex_state: Uint16_t(4)}, {}, {Lex_state: Uint16_t(9)}, {External_lex_state: Uint16_t(4)}, {External_lex_state: Uint16_t(4)}, {External_lex_state: Uint16_t(4)}, {Lex_state: Uint16_t(9)}, {External_lex_state: Uint16_t(4)}} /* parser.c:1502:18 */
+
+var ts_external_scanner_symbol_map = [5]TSSymbol{Sym__line_ending_or_eof, Sym__multiline_basic_string_content, Sym__multiline_basic_string_end, Sym__multiline_literal_string_content, Sym__multiline_literal_string_end} /* parser.c:1665:17 */
+
+var ts_external_scanner_states = [5][5]uint8{1: {uint8(True), uint8(True), uint8(True), uint8(True), uint8(True)}, 2: {1: uint8(True), 2: uint8(True)}, 3: {3: uint8(True), 4: uint8(True)}, 4: {0: uint8(True)}} /* parser.c:1673:13 */
+
+var ts_parse_table = [2][66]Uint16_t{{0: Uint16_t(1), 1: Uint16_t(1), 2: Uint16_t(3), 3: Uint16_t(1), 4: Uint16_t(1), 5: Uint16_t(1), 7: Uint16_t(1), 8: Uint16_t(1), 9: Uint16_t(1), 10: Uint16_t(1), 12: Uint16_t(1), 13: Uint16_t(1), 14: Uint16_t(1), 15: Uint16_t(1), 16: Uint16_t(1), 17: Uint16_t(1), 19: Uint16_t(1), 20: Uint16_t(1), 21: Uint16_t(1), 22: Uint16_t(1), 23: Uint16_t(1), 24: Uint16_t(1), 26: Uint16_t(1), 27: Uint16_t(1), 30: Uint16_t(1), 31: Uint16_t(1), 32: Uint16_t(1), 33: Uint16_t(1), 34: Uint16_t(1), 35: Uint16_t(1), 36: Uint16_t(1), 37: Uint16_t(1), 38: Uint16_t(1), 39: Uint16_t(1)}, {0: Uint16_t(5), 1: Uint16_t(7), 2: Uint16_t(3), 3: Uint16_t(9), 5: Uint16_t(11), 9: Uint16_t(13), 10: Uint16_t(15), 17: Uint16_t(17), 40: Uint16_t(145), 41: Uint16_t(52), 42: Uint16_t(52), 43: Uint16_t(27), 44: Uint16_t(144), 45: Uint16_t(121), 46: Uint16_t(121), 47: Uint16_t(121), 50: Uint16_t(100), 52: Uint16_t(100), 58: Uint16_t(27), 59: Uint16_t(52)}} /* parser.c:1694:17 */
+
+var ts_small_parse_table = [3265]Uint16_t{Uint16_t(17),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(19), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(21), Uint16_t(1),
+	Anon_sym_LBRACK,
+	Uint16_t(23), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(25), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(27), Uint16_t(1),
+	Anon_sym_DQUOTE_DQUOTE_DQUOTE,
+	Uint16_t(29), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(31), Uint16_t(1),
+	Anon_sym_SQUOTE_SQUOTE_SQUOTE,
+	Uint16_t(33), Uint16_t(1),
+	Aux_sym_integer_token1,
+	Uint16_t(43), Uint16_t(1),
+	Anon_sym_LBRACE,
+	Uint16_t(26), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(37), Uint16_t(2),
+	Aux_sym_float_token1,
+	Aux_sym_float_token2,
+	Uint16_t(41), Uint16_t(2),
+	Sym_local_date_time,
+	Sym_local_date,
+	Uint16_t(35), Uint16_t(3),
+	Aux_sym_integer_token2,
+	Aux_sym_integer_token3,
+	Aux_sym_integer_token4,
+	Uint16_t(39), Uint16_t(3),
+	Sym_boolean,
+	Sym_offset_date_time,
+	Sym_local_time,
+	Uint16_t(86), Uint16_t(4),
+	Sym__basic_string,
+	Sym__multiline_basic_string,
+	Sym__literal_string,
+	Sym__multiline_literal_string,
+	Uint16_t(55), Uint16_t(6),
+	Sym__inline_value,
+	Sym_string,
+	Sym_integer,
+	Sym_float,
+	Sym_array,
+	Sym_inline_table, Uint16_t(17),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(19), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(21), Uint16_t(1),
+	Anon_sym_LBRACK,
+	Uint16_t(25), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(27), Uint16_t(1),
+	Anon_sym_DQUOTE_DQUOTE_DQUOTE,
+	Uint16_t(29), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(31), Uint16_t(1),
+	Anon_sym_SQUOTE_SQUOTE_SQUOTE,
+	Uint16_t(33), Uint16_t(1),
+	Aux_sym_integer_token1,
+	Uint16_t(43), Uint16_t(1),
+	Anon_sym_LBRACE,
+	Uint16_t(45), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(26), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(37), Uint16_t(2),
+	Aux_sym_float_token1,
+	Aux_sym_float_token2,
+	Uint16_t(49), Uint16_t(2),
+	Sym_local_date_time,
+	Sym_local_date,
+	Uint16_t(35), Uint16_t(3),
+	Aux_sym_integer_token2,
+	Aux_sym_integer_token3,
+	Aux_sym_integer_token4,
+	Uint16_t(47), Uint16_t(3),
+	Sym_boolean,
+	Sym_offset_date_time,
+	Sym_local_time,
+	Uint16_t(86), Uint16_t(4),
+	Sym__basic_string,
+	Sym__multiline_basic_string,
+	Sym__literal_string,
+	Sym__multiline_literal_string,
+	Uint16_t(66), Uint16_t(6),
+	Sym__inline_value,
+	Sym_string,
+	Sym_integer,
+	Sym_float,
+	Sym_array,
+	Sym_inline_table, Uint16_t(17),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(21), Uint16_t(1),
+	Anon_sym_LBRACK,
+	Uint16_t(25), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(27), Uint16_t(1),
+	Anon_sym_DQUOTE_DQUOTE_DQUOTE,
+	Uint16_t(29), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(31), Uint16_t(1),
+	Anon_sym_SQUOTE_SQUOTE_SQUOTE,
+	Uint16_t(33), Uint16_t(1),
+	Aux_sym_integer_token1,
+	Uint16_t(43), Uint16_t(1),
+	Anon_sym_LBRACE,
+	Uint16_t(51), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(53), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(15), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(37), Uint16_t(2),
+	Aux_sym_float_token1,
+	Aux_sym_float_token2,
+	Uint16_t(57), Uint16_t(2),
+	Sym_local_date_time,
+	Sym_local_date,
+	Uint16_t(35), Uint16_t(3),
+	Aux_sym_integer_token2,
+	Aux_sym_integer_token3,
+	Aux_sym_integer_token4,
+	Uint16_t(55), Uint16_t(3),
+	Sym_boolean,
+	Sym_offset_date_time,
+	Sym_local_time,
+	Uint16_t(86), Uint16_t(4),
+	Sym__basic_string,
+	Sym__multiline_basic_string,
+	Sym__literal_string,
+	Sym__multiline_literal_string,
+	Uint16_t(68), Uint16_t(6),
+	Sym__inline_value,
+	Sym_string,
+	Sym_integer,
+	Sym_float,
+	Sym_array,
+	Sym_inline_table, Uint16_t(17),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(21), Uint16_t(1),
+	Anon_sym_LBRACK,
+	Uint16_t(25), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(27), Uint16_t(1),
+	Anon_sym_DQUOTE_DQUOTE_DQUOTE,
+	Uint16_t(29), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(31), Uint16_t(1),
+	Anon_sym_SQUOTE_SQUOTE_SQUOTE,
+	Uint16_t(33), Uint16_t(1),
+	Aux_sym_integer_token1,
+	Uint16_t(43), Uint16_t(1),
+	Anon_sym_LBRACE,
+	Uint16_t(59), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(61), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(14), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(37), Uint16_t(2),
+	Aux_sym_float_token1,
+	Aux_sym_float_token2,
+	Uint16_t(57), Uint16_t(2),
+	Sym_local_date_time,
+	Sym_local_date,
+	Uint16_t(35), Uint16_t(3),
+	Aux_sym_integer_token2,
+	Aux_sym_integer_token3,
+	Aux_sym_integer_token4,
+	Uint16_t(55), Uint16_t(3),
+	Sym_boolean,
+	Sym_offset_date_time,
+	Sym_local_time,
+	Uint16_t(86), Uint16_t(4),
+	Sym__basic_string,
+	Sym__multiline_basic_string,
+	Sym__literal_string,
+	Sym__multiline_literal_string,
+	Uint16_t(68), Uint16_t(6),
+	Sym__inline_value,
+	Sym_string,
+	Sym_integer,
+	Sym_float,
+	Sym_array,
+	Sym_inline_table, Uint16_t(17),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(19), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(21), Uint16_t(1),
+	Anon_sym_LBRACK,
+	Uint16_t(25), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(27), Uint16_t(1),
+	Anon_sym_DQUOTE_DQUOTE_DQUOTE,
+	Uint16_t(29), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(31), Uint16_t(1),
+	Anon_sym_SQUOTE_SQUOTE_SQUOTE,
+	Uint16_t(33), Uint16_t(1),
+	Aux_sym_integer_token1,
+	Uint16_t(43), Uint16_t(1),
+	Anon_sym_LBRACE,
+	Uint16_t(61), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(26), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(37), Uint16_t(2),
+	Aux_sym_float_token1,
+	Aux_sym_float_token2,
+	Uint16_t(49), Uint16_t(2),
+	Sym_local_date_time,
+	Sym_local_date,
+	Uint16_t(35), Uint16_t(3),
+	Aux_sym_integer_token2,
+	Aux_sym_integer_token3,
+	Aux_sym_integer_token4,
+	Uint16_t(47), Uint16_t(3),
+	Sym_boolean,
+	Sym_offset_date_time,
+	Sym_local_time,
+	Uint16_t(86), Uint16_t(4),
+	Sym__basic_string,
+	Sym__multiline_basic_string,
+	Sym__literal_string,
+	Sym__multiline_literal_string,
+	Uint16_t(66), Uint16_t(6),
+	Sym__inline_value,
+	Sym_string,
+	Sym_integer,
+	Sym_float,
+	Sym_array,
+	Sym_inline_table, Uint16_t(17),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(21), Uint16_t(1),
+	Anon_sym_LBRACK,
+	Uint16_t(25), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(27), Uint16_t(1),
+	Anon_sym_DQUOTE_DQUOTE_DQUOTE,
+	Uint16_t(29), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(31), Uint16_t(1),
+	Anon_sym_SQUOTE_SQUOTE_SQUOTE,
+	Uint16_t(33), Uint16_t(1),
+	Aux_sym_integer_token1,
+	Uint16_t(43), Uint16_t(1),
+	Anon_sym_LBRACE,
+	Uint16_t(63), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(65), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(6), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(37), Uint16_t(2),
+	Aux_sym_float_token1,
+	Aux_sym_float_token2,
+	Uint16_t(57), Uint16_t(2),
+	Sym_local_date_time,
+	Sym_local_date,
+	Uint16_t(35), Uint16_t(3),
+	Aux_sym_integer_token2,
+	Aux_sym_integer_token3,
+	Aux_sym_integer_token4,
+	Uint16_t(55), Uint16_t(3),
+	Sym_boolean,
+	Sym_offset_date_time,
+	Sym_local_time,
+	Uint16_t(86), Uint16_t(4),
+	Sym__basic_string,
+	Sym__multiline_basic_string,
+	Sym__literal_string,
+	Sym__multiline_literal_string,
+	Uint16_t(68), Uint16_t(6),
+	Sym__inline_value,
+	Sym_string,
+	Sym_integer,
+	Sym_float,
+	Sym_array,
+	Sym_inline_table, Uint16_t(17),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(19), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(21), Uint16_t(1),
+	Anon_sym_LBRACK,
+	Uint16_t(25), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(27), Uint16_t(1),
+	Anon_sym_DQUOTE_DQUOTE_DQUOTE,
+	Uint16_t(29), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(31), Uint16_t(1),
+	Anon_sym_SQUOTE_SQUOTE_SQUOTE,
+	Uint16_t(33), Uint16_t(1),
+	Aux_sym_integer_token1,
+	Uint16_t(43), Uint16_t(1),
+	Anon_sym_LBRACE,
+	Uint16_t(65), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(26), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(37), Uint16_t(2),
+	Aux_sym_float_token1,
+	Aux_sym_float_token2,
+	Uint16_t(49), Uint16_t(2),
+	Sym_local_date_time,
+	Sym_local_date,
+	Uint16_t(35), Uint16_t(3),
+	Aux_sym_integer_token2,
+	Aux_sym_integer_token3,
+	Aux_sym_integer_token4,
+	Uint16_t(47), Uint16_t(3),
+	Sym_boolean,
+	Sym_offset_date_time,
+	Sym_local_time,
+	Uint16_t(86), Uint16_t(4),
+	Sym__basic_string,
+	Sym__multiline_basic_string,
+	Sym__literal_string,
+	Sym__multiline_literal_string,
+	Uint16_t(66), Uint16_t(6),
+	Sym__inline_value,
+	Sym_string,
+	Sym_integer,
+	Sym_float,
+	Sym_array,
+	Sym_inline_table, Uint16_t(17),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(21), Uint16_t(1),
+	Anon_sym_LBRACK,
+	Uint16_t(25), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(27), Uint16_t(1),
+	Anon_sym_DQUOTE_DQUOTE_DQUOTE,
+	Uint16_t(29), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(31), Uint16_t(1),
+	Anon_sym_SQUOTE_SQUOTE_SQUOTE,
+	Uint16_t(33), Uint16_t(1),
+	Aux_sym_integer_token1,
+	Uint16_t(43), Uint16_t(1),
+	Anon_sym_LBRACE,
+	Uint16_t(67), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(69), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(10), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(37), Uint16_t(2),
+	Aux_sym_float_token1,
+	Aux_sym_float_token2,
+	Uint16_t(57), Uint16_t(2),
+	Sym_local_date_time,
+	Sym_local_date,
+	Uint16_t(35), Uint16_t(3),
+	Aux_sym_integer_token2,
+	Aux_sym_integer_token3,
+	Aux_sym_integer_token4,
+	Uint16_t(55), Uint16_t(3),
+	Sym_boolean,
+	Sym_offset_date_time,
+	Sym_local_time,
+	Uint16_t(86), Uint16_t(4),
+	Sym__basic_string,
+	Sym__multiline_basic_string,
+	Sym__literal_string,
+	Sym__multiline_literal_string,
+	Uint16_t(68), Uint16_t(6),
+	Sym__inline_value,
+	Sym_string,
+	Sym_integer,
+	Sym_float,
+	Sym_array,
+	Sym_inline_table, Uint16_t(17),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(19), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(21), Uint16_t(1),
+	Anon_sym_LBRACK,
+	Uint16_t(25), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(27), Uint16_t(1),
+	Anon_sym_DQUOTE_DQUOTE_DQUOTE,
+	Uint16_t(29), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(31), Uint16_t(1),
+	Anon_sym_SQUOTE_SQUOTE_SQUOTE,
+	Uint16_t(33), Uint16_t(1),
+	Aux_sym_integer_token1,
+	Uint16_t(43), Uint16_t(1),
+	Anon_sym_LBRACE,
+	Uint16_t(53), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(26), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(37), Uint16_t(2),
+	Aux_sym_float_token1,
+	Aux_sym_float_token2,
+	Uint16_t(49), Uint16_t(2),
+	Sym_local_date_time,
+	Sym_local_date,
+	Uint16_t(35), Uint16_t(3),
+	Aux_sym_integer_token2,
+	Aux_sym_integer_token3,
+	Aux_sym_integer_token4,
+	Uint16_t(47), Uint16_t(3),
+	Sym_boolean,
+	Sym_offset_date_time,
+	Sym_local_time,
+	Uint16_t(86), Uint16_t(4),
+	Sym__basic_string,
+	Sym__multiline_basic_string,
+	Sym__literal_string,
+	Sym__multiline_literal_string,
+	Uint16_t(66), Uint16_t(6),
+	Sym__inline_value,
+	Sym_string,
+	Sym_integer,
+	Sym_float,
+	Sym_array,
+	Sym_inline_table, Uint16_t(17),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(21), Uint16_t(1),
+	Anon_sym_LBRACK,
+	Uint16_t(25), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(27), Uint16_t(1),
+	Anon_sym_DQUOTE_DQUOTE_DQUOTE,
+	Uint16_t(29), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(31), Uint16_t(1),
+	Anon_sym_SQUOTE_SQUOTE_SQUOTE,
+	Uint16_t(33), Uint16_t(1),
+	Aux_sym_integer_token1,
+	Uint16_t(43), Uint16_t(1),
+	Anon_sym_LBRACE,
+	Uint16_t(71), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(73), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(18), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(37), Uint16_t(2),
+	Aux_sym_float_token1,
+	Aux_sym_float_token2,
+	Uint16_t(57), Uint16_t(2),
+	Sym_local_date_time,
+	Sym_local_date,
+	Uint16_t(35), Uint16_t(3),
+	Aux_sym_integer_token2,
+	Aux_sym_integer_token3,
+	Aux_sym_integer_token4,
+	Uint16_t(55), Uint16_t(3),
+	Sym_boolean,
+	Sym_offset_date_time,
+	Sym_local_time,
+	Uint16_t(86), Uint16_t(4),
+	Sym__basic_string,
+	Sym__multiline_basic_string,
+	Sym__literal_string,
+	Sym__multiline_literal_string,
+	Uint16_t(68), Uint16_t(6),
+	Sym__inline_value,
+	Sym_string,
+	Sym_integer,
+	Sym_float,
+	Sym_array,
+	Sym_inline_table, Uint16_t(17),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(21), Uint16_t(1),
+	Anon_sym_LBRACK,
+	Uint16_t(25), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(27), Uint16_t(1),
+	Anon_sym_DQUOTE_DQUOTE_DQUOTE,
+	Uint16_t(29), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(31), Uint16_t(1),
+	Anon_sym_SQUOTE_SQUOTE_SQUOTE,
+	Uint16_t(33), Uint16_t(1),
+	Aux_sym_integer_token1,
+	Uint16_t(43), Uint16_t(1),
+	Anon_sym_LBRACE,
+	Uint16_t(75), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(77), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(8), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(37), Uint16_t(2),
+	Aux_sym_float_token1,
+	Aux_sym_float_token2,
+	Uint16_t(57), Uint16_t(2),
+	Sym_local_date_time,
+	Sym_local_date,
+	Uint16_t(35), Uint16_t(3),
+	Aux_sym_integer_token2,
+	Aux_sym_integer_token3,
+	Aux_sym_integer_token4,
+	Uint16_t(55), Uint16_t(3),
+	Sym_boolean,
+	Sym_offset_date_time,
+	Sym_local_time,
+	Uint16_t(86), Uint16_t(4),
+	Sym__basic_string,
+	Sym__multiline_basic_string,
+	Sym__literal_string,
+	Sym__multiline_literal_string,
+	Uint16_t(68), Uint16_t(6),
+	Sym__inline_value,
+	Sym_string,
+	Sym_integer,
+	Sym_float,
+	Sym_array,
+	Sym_inline_table, Uint16_t(17),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(19), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(21), Uint16_t(1),
+	Anon_sym_LBRACK,
+	Uint16_t(25), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(27), Uint16_t(1),
+	Anon_sym_DQUOTE_DQUOTE_DQUOTE,
+	Uint16_t(29), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(31), Uint16_t(1),
+	Anon_sym_SQUOTE_SQUOTE_SQUOTE,
+	Uint16_t(33), Uint16_t(1),
+	Aux_sym_integer_token1,
+	Uint16_t(43), Uint16_t(1),
+	Anon_sym_LBRACE,
+	Uint16_t(77), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(26), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(37), Uint16_t(2),
+	Aux_sym_float_token1,
+	Aux_sym_float_token2,
+	Uint16_t(49), Uint16_t(2),
+	Sym_local_date_time,
+	Sym_local_date,
+	Uint16_t(35), Uint16_t(3),
+	Aux_sym_integer_token2,
+	Aux_sym_integer_token3,
+	Aux_sym_integer_token4,
+	Uint16_t(47), Uint16_t(3),
+	Sym_boolean,
+	Sym_offset_date_time,
+	Sym_local_time,
+	Uint16_t(86), Uint16_t(4),
+	Sym__basic_string,
+	Sym__multiline_basic_string,
+	Sym__literal_string,
+	Sym__multiline_literal_string,
+	Uint16_t(66), Uint16_t(6),
+	Sym__inline_value,
+	Sym_string,
+	Sym_integer,
+	Sym_float,
+	Sym_array,
+	Sym_inline_table, Uint16_t(17),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(19), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(21), Uint16_t(1),
+	Anon_sym_LBRACK,
+	Uint16_t(25), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(27), Uint16_t(1),
+	Anon_sym_DQUOTE_DQUOTE_DQUOTE,
+	Uint16_t(29), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(31), Uint16_t(1),
+	Anon_sym_SQUOTE_SQUOTE_SQUOTE,
+	Uint16_t(33), Uint16_t(1),
+	Aux_sym_integer_token1,
+	Uint16_t(43), Uint16_t(1),
+	Anon_sym_LBRACE,
+	Uint16_t(79), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(26), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(37), Uint16_t(2),
+	Aux_sym_float_token1,
+	Aux_sym_float_token2,
+	Uint16_t(49), Uint16_t(2),
+	Sym_local_date_time,
+	Sym_local_date,
+	Uint16_t(35), Uint16_t(3),
+	Aux_sym_integer_token2,
+	Aux_sym_integer_token3,
+	Aux_sym_integer_token4,
+	Uint16_t(47), Uint16_t(3),
+	Sym_boolean,
+	Sym_offset_date_time,
+	Sym_local_time,
+	Uint16_t(86), Uint16_t(4),
+	Sym__basic_string,
+	Sym__multiline_basic_string,
+	Sym__literal_string,
+	Sym__multiline_literal_string,
+	Uint16_t(66), Uint16_t(6),
+	Sym__inline_value,
+	Sym_string,
+	Sym_integer,
+	Sym_float,
+	Sym_array,
+	Sym_inline_table, Uint16_t(17),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(19), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(21), Uint16_t(1),
+	Anon_sym_LBRACK,
+	Uint16_t(25), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(27), Uint16_t(1),
+	Anon_sym_DQUOTE_DQUOTE_DQUOTE,
+	Uint16_t(29), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(31), Uint16_t(1),
+	Anon_sym_SQUOTE_SQUOTE_SQUOTE,
+	Uint16_t(33), Uint16_t(1),
+	Aux_sym_integer_token1,
+	Uint16_t(43), Uint16_t(1),
+	Anon_sym_LBRACE,
+	Uint16_t(81), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(26), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(37), Uint16_t(2),
+	Aux_sym_float_token1,
+	Aux_sym_float_token2,
+	Uint16_t(49), Uint16_t(2),
+	Sym_local_date_time,
+	Sym_local_date,
+	Uint16_t(35), Uint16_t(3),
+	Aux_sym_integer_token2,
+	Aux_sym_integer_token3,
+	Aux_sym_integer_token4,
+	Uint16_t(47), Uint16_t(3),
+	Sym_boolean,
+	Sym_offset_date_time,
+	Sym_local_time,
+	Uint16_t(86), Uint16_t(4),
+	Sym__basic_string,
+	Sym__multiline_basic_string,
+	Sym__literal_string,
+	Sym__multiline_literal_string,
+	Uint16_t(66), Uint16_t(6),
+	Sym__inline_value,
+	Sym_string,
+	Sym_integer,
+	Sym_float,
+	Sym_array,
+	Sym_inline_table, Uint16_t(17),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(21), Uint16_t(1),
+	Anon_sym_LBRACK,
+	Uint16_t(25), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(27), Uint16_t(1),
+	Anon_sym_DQUOTE_DQUOTE_DQUOTE,
+	Uint16_t(29), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(31), Uint16_t(1),
+	Anon_sym_SQUOTE_SQUOTE_SQUOTE,
+	Uint16_t(33), Uint16_t(1),
+	Aux_sym_integer_token1,
+	Uint16_t(43), Uint16_t(1),
+	Anon_sym_LBRACE,
+	Uint16_t(83), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(85), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(2), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(37), Uint16_t(2),
+	Aux_sym_float_token1,
+	Aux_sym_float_token2,
+	Uint16_t(89), Uint16_t(2),
+	Sym_local_date_time,
+	Sym_local_date,
+	Uint16_t(35), Uint16_t(3),
+	Aux_sym_integer_token2,
+	Aux_sym_integer_token3,
+	Aux_sym_integer_token4,
+	Uint16_t(87), Uint16_t(3),
+	Sym_boolean,
+	Sym_offset_date_time,
+	Sym_local_time,
+	Uint16_t(86), Uint16_t(4),
+	Sym__basic_string,
+	Sym__multiline_basic_string,
+	Sym__literal_string,
+	Sym__multiline_literal_string,
+	Uint16_t(54), Uint16_t(6),
+	Sym__inline_value,
+	Sym_string,
+	Sym_integer,
+	Sym_float,
+	Sym_array,
+	Sym_inline_table, Uint16_t(17),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(21), Uint16_t(1),
+	Anon_sym_LBRACK,
+	Uint16_t(25), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(27), Uint16_t(1),
+	Anon_sym_DQUOTE_DQUOTE_DQUOTE,
+	Uint16_t(29), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(31), Uint16_t(1),
+	Anon_sym_SQUOTE_SQUOTE_SQUOTE,
+	Uint16_t(33), Uint16_t(1),
+	Aux_sym_integer_token1,
+	Uint16_t(43), Uint16_t(1),
+	Anon_sym_LBRACE,
+	Uint16_t(81), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(91), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(3), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(37), Uint16_t(2),
+	Aux_sym_float_token1,
+	Aux_sym_float_token2,
+	Uint16_t(57), Uint16_t(2),
+	Sym_local_date_time,
+	Sym_local_date,
+	Uint16_t(35), Uint16_t(3),
+	Aux_sym_integer_token2,
+	Aux_sym_integer_token3,
+	Aux_sym_integer_token4,
+	Uint16_t(55), Uint16_t(3),
+	Sym_boolean,
+	Sym_offset_date_time,
+	Sym_local_time,
+	Uint16_t(86), Uint16_t(4),
+	Sym__basic_string,
+	Sym__multiline_basic_string,
+	Sym__literal_string,
+	Sym__multiline_literal_string,
+	Uint16_t(68), Uint16_t(6),
+	Sym__inline_value,
+	Sym_string,
+	Sym_integer,
+	Sym_float,
+	Sym_array,
+	Sym_inline_table, Uint16_t(17),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(19), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(21), Uint16_t(1),
+	Anon_sym_LBRACK,
+	Uint16_t(25), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(27), Uint16_t(1),
+	Anon_sym_DQUOTE_DQUOTE_DQUOTE,
+	Uint16_t(29), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(31), Uint16_t(1),
+	Anon_sym_SQUOTE_SQUOTE_SQUOTE,
+	Uint16_t(33), Uint16_t(1),
+	Aux_sym_integer_token1,
+	Uint16_t(43), Uint16_t(1),
+	Anon_sym_LBRACE,
+	Uint16_t(69), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(26), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(37), Uint16_t(2),
+	Aux_sym_float_token1,
+	Aux_sym_float_token2,
+	Uint16_t(49), Uint16_t(2),
+	Sym_local_date_time,
+	Sym_local_date,
+	Uint16_t(35), Uint16_t(3),
+	Aux_sym_integer_token2,
+	Aux_sym_integer_token3,
+	Aux_sym_integer_token4,
+	Uint16_t(47), Uint16_t(3),
+	Sym_boolean,
+	Sym_offset_date_time,
+	Sym_local_time,
+	Uint16_t(86), Uint16_t(4),
+	Sym__basic_string,
+	Sym__multiline_basic_string,
+	Sym__literal_string,
+	Sym__multiline_literal_string,
+	Uint16_t(66), Uint16_t(6),
+	Sym__inline_value,
+	Sym_string,
+	Sym_integer,
+	Sym_float,
+	Sym_array,
+	Sym_inline_table, Uint16_t(17),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(21), Uint16_t(1),
+	Anon_sym_LBRACK,
+	Uint16_t(25), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(27), Uint16_t(1),
+	Anon_sym_DQUOTE_DQUOTE_DQUOTE,
+	Uint16_t(29), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(31), Uint16_t(1),
+	Anon_sym_SQUOTE_SQUOTE_SQUOTE,
+	Uint16_t(33), Uint16_t(1),
+	Aux_sym_integer_token1,
+	Uint16_t(43), Uint16_t(1),
+	Anon_sym_LBRACE,
+	Uint16_t(93), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(95), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(13), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(37), Uint16_t(2),
+	Aux_sym_float_token1,
+	Aux_sym_float_token2,
+	Uint16_t(57), Uint16_t(2),
+	Sym_local_date_time,
+	Sym_local_date,
+	Uint16_t(35), Uint16_t(3),
+	Aux_sym_integer_token2,
+	Aux_sym_integer_token3,
+	Aux_sym_integer_token4,
+	Uint16_t(55), Uint16_t(3),
+	Sym_boolean,
+	Sym_offset_date_time,
+	Sym_local_time,
+	Uint16_t(86), Uint16_t(4),
+	Sym__basic_string,
+	Sym__multiline_basic_string,
+	Sym__literal_string,
+	Sym__multiline_literal_string,
+	Uint16_t(68), Uint16_t(6),
+	Sym__inline_value,
+	Sym_string,
+	Sym_integer,
+	Sym_float,
+	Sym_array,
+	Sym_inline_table, Uint16_t(17),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(21), Uint16_t(1),
+	Anon_sym_LBRACK,
+	Uint16_t(25), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(27), Uint16_t(1),
+	Anon_sym_DQUOTE_DQUOTE_DQUOTE,
+	Uint16_t(29), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(31), Uint16_t(1),
+	Anon_sym_SQUOTE_SQUOTE_SQUOTE,
+	Uint16_t(33), Uint16_t(1),
+	Aux_sym_integer_token1,
+	Uint16_t(43), Uint16_t(1),
+	Anon_sym_LBRACE,
+	Uint16_t(97), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(99), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(21), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(37), Uint16_t(2),
+	Aux_sym_float_token1,
+	Aux_sym_float_token2,
+	Uint16_t(103), Uint16_t(2),
+	Sym_local_date_time,
+	Sym_local_date,
+	Uint16_t(35), Uint16_t(3),
+	Aux_sym_integer_token2,
+	Aux_sym_integer_token3,
+	Aux_sym_integer_token4,
+	Uint16_t(101), Uint16_t(3),
+	Sym_boolean,
+	Sym_offset_date_time,
+	Sym_local_time,
+	Uint16_t(86), Uint16_t(4),
+	Sym__basic_string,
+	Sym__multiline_basic_string,
+	Sym__literal_string,
+	Sym__multiline_literal_string,
+	Uint16_t(61), Uint16_t(6),
+	Sym__inline_value,
+	Sym_string,
+	Sym_integer,
+	Sym_float,
+	Sym_array,
+	Sym_inline_table, Uint16_t(17),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(19), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(21), Uint16_t(1),
+	Anon_sym_LBRACK,
+	Uint16_t(25), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(27), Uint16_t(1),
+	Anon_sym_DQUOTE_DQUOTE_DQUOTE,
+	Uint16_t(29), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(31), Uint16_t(1),
+	Anon_sym_SQUOTE_SQUOTE_SQUOTE,
+	Uint16_t(33), Uint16_t(1),
+	Aux_sym_integer_token1,
+	Uint16_t(43), Uint16_t(1),
+	Anon_sym_LBRACE,
+	Uint16_t(105), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(26), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(37), Uint16_t(2),
+	Aux_sym_float_token1,
+	Aux_sym_float_token2,
+	Uint16_t(109), Uint16_t(2),
+	Sym_local_date_time,
+	Sym_local_date,
+	Uint16_t(35), Uint16_t(3),
+	Aux_sym_integer_token2,
+	Aux_sym_integer_token3,
+	Aux_sym_integer_token4,
+	Uint16_t(107), Uint16_t(3),
+	Sym_boolean,
+	Sym_offset_date_time,
+	Sym_local_time,
+	Uint16_t(86), Uint16_t(4),
+	Sym__basic_string,
+	Sym__multiline_basic_string,
+	Sym__literal_string,
+	Sym__multiline_literal_string,
+	Uint16_t(53), Uint16_t(6),
+	Sym__inline_value,
+	Sym_string,
+	Sym_integer,
+	Sym_float,
+	Sym_array,
+	Sym_inline_table, Uint16_t(16),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(21), Uint16_t(1),
+	Anon_sym_LBRACK,
+	Uint16_t(25), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(27), Uint16_t(1),
+	Anon_sym_DQUOTE_DQUOTE_DQUOTE,
+	Uint16_t(29), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(31), Uint16_t(1),
+	Anon_sym_SQUOTE_SQUOTE_SQUOTE,
+	Uint16_t(33), Uint16_t(1),
+	Aux_sym_integer_token1,
+	Uint16_t(43), Uint16_t(1),
+	Anon_sym_LBRACE,
+	Uint16_t(111), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(23), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(37), Uint16_t(2),
+	Aux_sym_float_token1,
+	Aux_sym_float_token2,
+	Uint16_t(57), Uint16_t(2),
+	Sym_local_date_time,
+	Sym_local_date,
+	Uint16_t(35), Uint16_t(3),
+	Aux_sym_integer_token2,
+	Aux_sym_integer_token3,
+	Aux_sym_integer_token4,
+	Uint16_t(55), Uint16_t(3),
+	Sym_boolean,
+	Sym_offset_date_time,
+	Sym_local_time,
+	Uint16_t(86), Uint16_t(4),
+	Sym__basic_string,
+	Sym__multiline_basic_string,
+	Sym__literal_string,
+	Sym__multiline_literal_string,
+	Uint16_t(68), Uint16_t(6),
+	Sym__inline_value,
+	Sym_string,
+	Sym_integer,
+	Sym_float,
+	Sym_array,
+	Sym_inline_table, Uint16_t(16),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(19), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(21), Uint16_t(1),
+	Anon_sym_LBRACK,
+	Uint16_t(25), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(27), Uint16_t(1),
+	Anon_sym_DQUOTE_DQUOTE_DQUOTE,
+	Uint16_t(29), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(31), Uint16_t(1),
+	Anon_sym_SQUOTE_SQUOTE_SQUOTE,
+	Uint16_t(33), Uint16_t(1),
+	Aux_sym_integer_token1,
+	Uint16_t(43), Uint16_t(1),
+	Anon_sym_LBRACE,
+	Uint16_t(26), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(37), Uint16_t(2),
+	Aux_sym_float_token1,
+	Aux_sym_float_token2,
+	Uint16_t(49), Uint16_t(2),
+	Sym_local_date_time,
+	Sym_local_date,
+	Uint16_t(35), Uint16_t(3),
+	Aux_sym_integer_token2,
+	Aux_sym_integer_token3,
+	Aux_sym_integer_token4,
+	Uint16_t(47), Uint16_t(3),
+	Sym_boolean,
+	Sym_offset_date_time,
+	Sym_local_time,
+	Uint16_t(86), Uint16_t(4),
+	Sym__basic_string,
+	Sym__multiline_basic_string,
+	Sym__literal_string,
+	Sym__multiline_literal_string,
+	Uint16_t(66), Uint16_t(6),
+	Sym__inline_value,
+	Sym_string,
+	Sym_integer,
+	Sym_float,
+	Sym_array,
+	Sym_inline_table, Uint16_t(14),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(21), Uint16_t(1),
+	Anon_sym_LBRACK,
+	Uint16_t(25), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(27), Uint16_t(1),
+	Anon_sym_DQUOTE_DQUOTE_DQUOTE,
+	Uint16_t(29), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(31), Uint16_t(1),
+	Anon_sym_SQUOTE_SQUOTE_SQUOTE,
+	Uint16_t(33), Uint16_t(1),
+	Aux_sym_integer_token1,
+	Uint16_t(43), Uint16_t(1),
+	Anon_sym_LBRACE,
+	Uint16_t(37), Uint16_t(2),
+	Aux_sym_float_token1,
+	Aux_sym_float_token2,
+	Uint16_t(115), Uint16_t(2),
+	Sym_local_date_time,
+	Sym_local_date,
+	Uint16_t(35), Uint16_t(3),
+	Aux_sym_integer_token2,
+	Aux_sym_integer_token3,
+	Aux_sym_integer_token4,
+	Uint16_t(113), Uint16_t(3),
+	Sym_boolean,
+	Sym_offset_date_time,
+	Sym_local_time,
+	Uint16_t(86), Uint16_t(4),
+	Sym__basic_string,
+	Sym__multiline_basic_string,
+	Sym__literal_string,
+	Sym__multiline_literal_string,
+	Uint16_t(110), Uint16_t(6),
+	Sym__inline_value,
+	Sym_string,
+	Sym_integer,
+	Sym_float,
+	Sym_array,
+	Sym_inline_table, Uint16_t(14),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(117), Uint16_t(1),
+	Anon_sym_LBRACK,
+	Uint16_t(119), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(121), Uint16_t(1),
+	Anon_sym_DQUOTE_DQUOTE_DQUOTE,
+	Uint16_t(123), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(125), Uint16_t(1),
+	Anon_sym_SQUOTE_SQUOTE_SQUOTE,
+	Uint16_t(127), Uint16_t(1),
+	Aux_sym_integer_token1,
+	Uint16_t(137), Uint16_t(1),
+	Anon_sym_LBRACE,
+	Uint16_t(131), Uint16_t(2),
+	Aux_sym_float_token1,
+	Aux_sym_float_token2,
+	Uint16_t(135), Uint16_t(2),
+	Sym_local_date_time,
+	Sym_local_date,
+	Uint16_t(129), Uint16_t(3),
+	Aux_sym_integer_token2,
+	Aux_sym_integer_token3,
+	Aux_sym_integer_token4,
+	Uint16_t(133), Uint16_t(3),
+	Sym_boolean,
+	Sym_offset_date_time,
+	Sym_local_time,
+	Uint16_t(126), Uint16_t(4),
+	Sym__basic_string,
+	Sym__multiline_basic_string,
+	Sym__literal_string,
+	Sym__multiline_literal_string,
+	Uint16_t(127), Uint16_t(6),
+	Sym__inline_value,
+	Sym_string,
+	Sym_integer,
+	Sym_float,
+	Sym_array,
+	Sym_inline_table, Uint16_t(5),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(139), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(26), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(144), Uint16_t(5),
+	Anon_sym_DQUOTE,
+	Anon_sym_SQUOTE,
+	Aux_sym_integer_token1,
+	Sym_local_date_time,
+	Sym_local_date,
+	Uint16_t(142), Uint16_t(14),
+	Anon_sym_LBRACK,
+	Anon_sym_RBRACK,
+	Anon_sym_DQUOTE_DQUOTE_DQUOTE,
+	Anon_sym_SQUOTE_SQUOTE_SQUOTE,
+	Aux_sym_integer_token2,
+	Aux_sym_integer_token3,
+	Aux_sym_integer_token4,
+	Aux_sym_float_token1,
+	Aux_sym_float_token2,
+	Sym_boolean,
+	Sym_offset_date_time,
+	Sym_local_time,
+	Anon_sym_COMMA,
+	Anon_sym_LBRACE, Uint16_t(13),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(9), Uint16_t(1),
+	Anon_sym_LBRACK,
+	Uint16_t(11), Uint16_t(1),
+	Anon_sym_LBRACK_LBRACK,
+	Uint16_t(13), Uint16_t(1),
+	Sym_bare_key,
+	Uint16_t(15), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(17), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(146), Uint16_t(1),
+	Uint16_t(Ts_builtin_sym_end),
+	Uint16_t(148), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(144), Uint16_t(1),
+	Sym__inline_pair,
+	Uint16_t(32), Uint16_t(2),
+	Sym_pair,
+	Aux_sym_document_repeat1,
+	Uint16_t(100), Uint16_t(2),
+	Sym__basic_string,
+	Sym__literal_string,
+	Uint16_t(48), Uint16_t(3),
+	Sym_table,
+	Sym_table_array_element,
+	Aux_sym_document_repeat2,
+	Uint16_t(121), Uint16_t(3),
+	Sym__key,
+	Sym_dotted_key,
+	Sym_quoted_key, Uint16_t(11),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(13), Uint16_t(1),
+	Sym_bare_key,
+	Uint16_t(15), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(17), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(152), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(154), Uint16_t(1),
+	Anon_sym_LBRACK,
+	Uint16_t(144), Uint16_t(1),
+	Sym__inline_pair,
+	Uint16_t(150), Uint16_t(2),
+	Uint16_t(Ts_builtin_sym_end),
+	Anon_sym_LBRACK_LBRACK,
+	Uint16_t(31), Uint16_t(2),
+	Sym_pair,
+	Aux_sym_document_repeat1,
+	Uint16_t(100), Uint16_t(2),
+	Sym__basic_string,
+	Sym__literal_string,
+	Uint16_t(121), Uint16_t(3),
+	Sym__key,
+	Sym_dotted_key,
+	Sym_quoted_key, Uint16_t(11),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(13), Uint16_t(1),
+	Sym_bare_key,
+	Uint16_t(15), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(17), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(158), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(160), Uint16_t(1),
+	Anon_sym_LBRACK,
+	Uint16_t(144), Uint16_t(1),
+	Sym__inline_pair,
+	Uint16_t(156), Uint16_t(2),
+	Uint16_t(Ts_builtin_sym_end),
+	Anon_sym_LBRACK_LBRACK,
+	Uint16_t(30), Uint16_t(2),
+	Sym_pair,
+	Aux_sym_document_repeat1,
+	Uint16_t(100), Uint16_t(2),
+	Sym__basic_string,
+	Sym__literal_string,
+	Uint16_t(121), Uint16_t(3),
+	Sym__key,
+	Sym_dotted_key,
+	Sym_quoted_key, Uint16_t(11),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(13), Uint16_t(1),
+	Sym_bare_key,
+	Uint16_t(15), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(17), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(148), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(164), Uint16_t(1),
+	Anon_sym_LBRACK,
+	Uint16_t(144), Uint16_t(1),
+	Sym__inline_pair,
+	Uint16_t(162), Uint16_t(2),
+	Uint16_t(Ts_builtin_sym_end),
+	Anon_sym_LBRACK_LBRACK,
+	Uint16_t(32), Uint16_t(2),
+	Sym_pair,
+	Aux_sym_document_repeat1,
+	Uint16_t(100), Uint16_t(2),
+	Sym__basic_string,
+	Sym__literal_string,
+	Uint16_t(121), Uint16_t(3),
+	Sym__key,
+	Sym_dotted_key,
+	Sym_quoted_key, Uint16_t(11),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(13), Uint16_t(1),
+	Sym_bare_key,
+	Uint16_t(15), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(17), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(148), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(168), Uint16_t(1),
+	Anon_sym_LBRACK,
+	Uint16_t(144), Uint16_t(1),
+	Sym__inline_pair,
+	Uint16_t(166), Uint16_t(2),
+	Uint16_t(Ts_builtin_sym_end),
+	Anon_sym_LBRACK_LBRACK,
+	Uint16_t(32), Uint16_t(2),
+	Sym_pair,
+	Aux_sym_document_repeat1,
+	Uint16_t(100), Uint16_t(2),
+	Sym__basic_string,
+	Sym__literal_string,
+	Uint16_t(121), Uint16_t(3),
+	Sym__key,
+	Sym_dotted_key,
+	Sym_quoted_key, Uint16_t(11),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(172), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(175), Uint16_t(1),
+	Anon_sym_LBRACK,
+	Uint16_t(177), Uint16_t(1),
+	Sym_bare_key,
+	Uint16_t(180), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(183), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(144), Uint16_t(1),
+	Sym__inline_pair,
+	Uint16_t(170), Uint16_t(2),
+	Uint16_t(Ts_builtin_sym_end),
+	Anon_sym_LBRACK_LBRACK,
+	Uint16_t(32), Uint16_t(2),
+	Sym_pair,
+	Aux_sym_document_repeat1,
+	Uint16_t(100), Uint16_t(2),
+	Sym__basic_string,
+	Sym__literal_string,
+	Uint16_t(121), Uint16_t(3),
+	Sym__key,
+	Sym_dotted_key,
+	Sym_quoted_key, Uint16_t(8),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(15), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(17), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(186), Uint16_t(1),
+	Sym_bare_key,
+	Uint16_t(188), Uint16_t(1),
+	Anon_sym_RBRACE,
+	Uint16_t(94), Uint16_t(1),
+	Sym__inline_pair,
+	Uint16_t(100), Uint16_t(2),
+	Sym__basic_string,
+	Sym__literal_string,
+	Uint16_t(112), Uint16_t(3),
+	Sym__key,
+	Sym_dotted_key,
+	Sym_quoted_key, Uint16_t(8),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(15), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(17), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(186), Uint16_t(1),
+	Sym_bare_key,
+	Uint16_t(190), Uint16_t(1),
+	Anon_sym_RBRACE,
+	Uint16_t(99), Uint16_t(1),
+	Sym__inline_pair,
+	Uint16_t(100), Uint16_t(2),
+	Sym__basic_string,
+	Sym__literal_string,
+	Uint16_t(112), Uint16_t(3),
+	Sym__key,
+	Sym_dotted_key,
+	Sym_quoted_key, Uint16_t(7),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(15), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(17), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(186), Uint16_t(1),
+	Sym_bare_key,
+	Uint16_t(114), Uint16_t(1),
+	Sym__inline_pair,
+	Uint16_t(100), Uint16_t(2),
+	Sym__basic_string,
+	Sym__literal_string,
+	Uint16_t(112), Uint16_t(3),
+	Sym__key,
+	Sym_dotted_key,
+	Sym_quoted_key, Uint16_t(6),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(15), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(17), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(192), Uint16_t(1),
+	Sym_bare_key,
+	Uint16_t(100), Uint16_t(2),
+	Sym__basic_string,
+	Sym__literal_string,
+	Uint16_t(109), Uint16_t(3),
+	Sym__key,
+	Sym_dotted_key,
+	Sym_quoted_key, Uint16_t(6),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(194), Uint16_t(1),
+	Sym_bare_key,
+	Uint16_t(196), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(198), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(122), Uint16_t(2),
+	Sym__basic_string,
+	Sym__literal_string,
+	Uint16_t(108), Uint16_t(3),
+	Sym__key,
+	Sym_dotted_key,
+	Sym_quoted_key, Uint16_t(4),
+	Uint16_t(200), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(204), Uint16_t(1),
+	Sym__multiline_basic_string_end,
+	Uint16_t(39), Uint16_t(1),
+	Aux_sym__multiline_basic_string_repeat1,
+	Uint16_t(202), Uint16_t(5),
+	Sym__multiline_basic_string_content,
+	Aux_sym__basic_string_token1,
+	Aux_sym__multiline_basic_string_token1,
+	Sym_escape_sequence,
+	Sym__escape_line_ending, Uint16_t(4),
+	Uint16_t(200), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(209), Uint16_t(1),
+	Sym__multiline_basic_string_end,
+	Uint16_t(39), Uint16_t(1),
+	Aux_sym__multiline_basic_string_repeat1,
+	Uint16_t(206), Uint16_t(5),
+	Sym__multiline_basic_string_content,
+	Aux_sym__basic_string_token1,
+	Aux_sym__multiline_basic_string_token1,
+	Sym_escape_sequence,
+	Sym__escape_line_ending, Uint16_t(4),
+	Uint16_t(200), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(213), Uint16_t(1),
+	Sym__multiline_basic_string_end,
+	Uint16_t(38), Uint16_t(1),
+	Aux_sym__multiline_basic_string_repeat1,
+	Uint16_t(211), Uint16_t(5),
+	Sym__multiline_basic_string_content,
+	Aux_sym__basic_string_token1,
+	Aux_sym__multiline_basic_string_token1,
+	Sym_escape_sequence,
+	Sym__escape_line_ending, Uint16_t(4),
+	Uint16_t(200), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(217), Uint16_t(1),
+	Sym__multiline_basic_string_end,
+	Uint16_t(42), Uint16_t(1),
+	Aux_sym__multiline_basic_string_repeat1,
+	Uint16_t(215), Uint16_t(5),
+	Sym__multiline_basic_string_content,
+	Aux_sym__basic_string_token1,
+	Aux_sym__multiline_basic_string_token1,
+	Sym_escape_sequence,
+	Sym__escape_line_ending, Uint16_t(4),
+	Uint16_t(200), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(219), Uint16_t(1),
+	Sym__multiline_basic_string_end,
+	Uint16_t(39), Uint16_t(1),
+	Aux_sym__multiline_basic_string_repeat1,
+	Uint16_t(202), Uint16_t(5),
+	Sym__multiline_basic_string_content,
+	Aux_sym__basic_string_token1,
+	Aux_sym__multiline_basic_string_token1,
+	Sym_escape_sequence,
+	Sym__escape_line_ending, Uint16_t(6),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(196), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(198), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(221), Uint16_t(1),
+	Sym_bare_key,
+	Uint16_t(111), Uint16_t(2),
+	Sym__key,
+	Sym_quoted_key,
+	Uint16_t(122), Uint16_t(2),
+	Sym__basic_string,
+	Sym__literal_string, Uint16_t(6),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(15), Uint16_t(1),
+	Anon_sym_DQUOTE,
+	Uint16_t(17), Uint16_t(1),
+	Anon_sym_SQUOTE,
+	Uint16_t(223), Uint16_t(1),
+	Sym_bare_key,
+	Uint16_t(100), Uint16_t(2),
+	Sym__basic_string,
+	Sym__literal_string,
+	Uint16_t(105), Uint16_t(2),
+	Sym__key,
+	Sym_quoted_key, Uint16_t(3),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(227), Uint16_t(1),
+	Anon_sym_LBRACK,
+	Uint16_t(225), Uint16_t(6),
+	Uint16_t(Ts_builtin_sym_end),
+	Aux_sym_document_token1,
+	Anon_sym_LBRACK_LBRACK,
+	Sym_bare_key,
+	Anon_sym_DQUOTE,
+	Anon_sym_SQUOTE, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(229), Uint16_t(6),
+	Aux_sym_document_token1,
+	Anon_sym_RBRACK,
+	Anon_sym_EQ,
+	Anon_sym_DOT,
+	Anon_sym_COMMA,
+	Anon_sym_RBRACE, Uint16_t(5),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(231), Uint16_t(1),
+	Uint16_t(Ts_builtin_sym_end),
+	Uint16_t(233), Uint16_t(1),
+	Anon_sym_LBRACK,
+	Uint16_t(236), Uint16_t(1),
+	Anon_sym_LBRACK_LBRACK,
+	Uint16_t(47), Uint16_t(3),
+	Sym_table,
+	Sym_table_array_element,
+	Aux_sym_document_repeat2, Uint16_t(5),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(9), Uint16_t(1),
+	Anon_sym_LBRACK,
+	Uint16_t(11), Uint16_t(1),
+	Anon_sym_LBRACK_LBRACK,
+	Uint16_t(239), Uint16_t(1),
+	Uint16_t(Ts_builtin_sym_end),
+	Uint16_t(47), Uint16_t(3),
+	Sym_table,
+	Sym_table_array_element,
+	Aux_sym_document_repeat2, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(241), Uint16_t(6),
+	Aux_sym_document_token1,
+	Anon_sym_RBRACK,
+	Anon_sym_EQ,
+	Anon_sym_DOT,
+	Anon_sym_COMMA,
+	Anon_sym_RBRACE, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(243), Uint16_t(6),
+	Aux_sym_document_token1,
+	Anon_sym_RBRACK,
+	Anon_sym_EQ,
+	Anon_sym_DOT,
+	Anon_sym_COMMA,
+	Anon_sym_RBRACE, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(245), Uint16_t(6),
+	Aux_sym_document_token1,
+	Anon_sym_RBRACK,
+	Anon_sym_EQ,
+	Anon_sym_DOT,
+	Anon_sym_COMMA,
+	Anon_sym_RBRACE, Uint16_t(5),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(9), Uint16_t(1),
+	Anon_sym_LBRACK,
+	Uint16_t(11), Uint16_t(1),
+	Anon_sym_LBRACK_LBRACK,
+	Uint16_t(146), Uint16_t(1),
+	Uint16_t(Ts_builtin_sym_end),
+	Uint16_t(47), Uint16_t(3),
+	Sym_table,
+	Sym_table_array_element,
+	Aux_sym_document_repeat2, Uint16_t(6),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(95), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(247), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(249), Uint16_t(1),
+	Anon_sym_COMMA,
+	Uint16_t(58), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(101), Uint16_t(1),
+	Aux_sym_array_repeat2, Uint16_t(6),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(23), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(251), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(253), Uint16_t(1),
+	Anon_sym_COMMA,
+	Uint16_t(57), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(96), Uint16_t(1),
+	Aux_sym_array_repeat2, Uint16_t(6),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(73), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(255), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(257), Uint16_t(1),
+	Anon_sym_COMMA,
+	Uint16_t(65), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(106), Uint16_t(1),
+	Aux_sym_array_repeat2, Uint16_t(4),
+	Uint16_t(200), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(261), Uint16_t(1),
+	Sym__multiline_literal_string_end,
+	Uint16_t(60), Uint16_t(1),
+	Aux_sym__multiline_literal_string_repeat1,
+	Uint16_t(259), Uint16_t(3),
+	Sym__multiline_literal_string_content,
+	Aux_sym__multiline_basic_string_token1,
+	Aux_sym__literal_string_token1, Uint16_t(6),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(19), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(73), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(257), Uint16_t(1),
+	Anon_sym_COMMA,
+	Uint16_t(26), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(106), Uint16_t(1),
+	Aux_sym_array_repeat2, Uint16_t(6),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(19), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(77), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(263), Uint16_t(1),
+	Anon_sym_COMMA,
+	Uint16_t(26), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(98), Uint16_t(1),
+	Aux_sym_array_repeat2, Uint16_t(4),
+	Uint16_t(200), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(267), Uint16_t(1),
+	Sym__multiline_literal_string_end,
+	Uint16_t(63), Uint16_t(1),
+	Aux_sym__multiline_literal_string_repeat1,
+	Uint16_t(265), Uint16_t(3),
+	Sym__multiline_literal_string_content,
+	Aux_sym__multiline_basic_string_token1,
+	Aux_sym__literal_string_token1, Uint16_t(4),
+	Uint16_t(200), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(272), Uint16_t(1),
+	Sym__multiline_literal_string_end,
+	Uint16_t(60), Uint16_t(1),
+	Aux_sym__multiline_literal_string_repeat1,
+	Uint16_t(269), Uint16_t(3),
+	Sym__multiline_literal_string_content,
+	Aux_sym__multiline_basic_string_token1,
+	Aux_sym__literal_string_token1, Uint16_t(6),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(105), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(274), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(276), Uint16_t(1),
+	Anon_sym_COMMA,
+	Uint16_t(64), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(103), Uint16_t(1),
+	Aux_sym_array_repeat2, Uint16_t(4),
+	Uint16_t(200), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(280), Uint16_t(1),
+	Sym__multiline_literal_string_end,
+	Uint16_t(56), Uint16_t(1),
+	Aux_sym__multiline_literal_string_repeat1,
+	Uint16_t(278), Uint16_t(3),
+	Sym__multiline_literal_string_content,
+	Aux_sym__multiline_basic_string_token1,
+	Aux_sym__literal_string_token1, Uint16_t(4),
+	Uint16_t(200), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(282), Uint16_t(1),
+	Sym__multiline_literal_string_end,
+	Uint16_t(60), Uint16_t(1),
+	Aux_sym__multiline_literal_string_repeat1,
+	Uint16_t(259), Uint16_t(3),
+	Sym__multiline_literal_string_content,
+	Aux_sym__multiline_basic_string_token1,
+	Aux_sym__literal_string_token1, Uint16_t(6),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(19), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(95), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(249), Uint16_t(1),
+	Anon_sym_COMMA,
+	Uint16_t(26), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(101), Uint16_t(1),
+	Aux_sym_array_repeat2, Uint16_t(6),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(19), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(69), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(284), Uint16_t(1),
+	Anon_sym_COMMA,
+	Uint16_t(26), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(95), Uint16_t(1),
+	Aux_sym_array_repeat2, Uint16_t(4),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(286), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(81), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(288), Uint16_t(2),
+	Anon_sym_RBRACK,
+	Anon_sym_COMMA, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(290), Uint16_t(4),
+	Aux_sym_document_token1,
+	Anon_sym_RBRACK,
+	Anon_sym_COMMA,
+	Anon_sym_RBRACE, Uint16_t(4),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(292), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(74), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(294), Uint16_t(2),
+	Anon_sym_RBRACK,
+	Anon_sym_COMMA, Uint16_t(4),
+	Uint16_t(200), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(298), Uint16_t(1),
+	Anon_sym_DQUOTE2,
+	Uint16_t(72), Uint16_t(1),
+	Aux_sym__basic_string_repeat1,
+	Uint16_t(296), Uint16_t(2),
+	Aux_sym__basic_string_token1,
+	Sym_escape_sequence, Uint16_t(4),
+	Uint16_t(200), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(302), Uint16_t(1),
+	Anon_sym_DQUOTE2,
+	Uint16_t(69), Uint16_t(1),
+	Aux_sym__basic_string_repeat1,
+	Uint16_t(300), Uint16_t(2),
+	Aux_sym__basic_string_token1,
+	Sym_escape_sequence, Uint16_t(4),
+	Uint16_t(200), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(304), Uint16_t(1),
+	Anon_sym_DQUOTE2,
+	Uint16_t(72), Uint16_t(1),
+	Aux_sym__basic_string_repeat1,
+	Uint16_t(296), Uint16_t(2),
+	Aux_sym__basic_string_token1,
+	Sym_escape_sequence, Uint16_t(4),
+	Uint16_t(200), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(309), Uint16_t(1),
+	Anon_sym_DQUOTE2,
+	Uint16_t(72), Uint16_t(1),
+	Aux_sym__basic_string_repeat1,
+	Uint16_t(306), Uint16_t(2),
+	Aux_sym__basic_string_token1,
+	Sym_escape_sequence, Uint16_t(4),
+	Uint16_t(200), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(311), Uint16_t(1),
+	Anon_sym_DQUOTE2,
+	Uint16_t(72), Uint16_t(1),
+	Aux_sym__basic_string_repeat1,
+	Uint16_t(296), Uint16_t(2),
+	Aux_sym__basic_string_token1,
+	Sym_escape_sequence, Uint16_t(4),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(19), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(26), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(288), Uint16_t(2),
+	Anon_sym_RBRACK,
+	Anon_sym_COMMA, Uint16_t(4),
+	Uint16_t(200), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(315), Uint16_t(1),
+	Anon_sym_DQUOTE2,
+	Uint16_t(73), Uint16_t(1),
+	Aux_sym__basic_string_repeat1,
+	Uint16_t(313), Uint16_t(2),
+	Aux_sym__basic_string_token1,
+	Sym_escape_sequence, Uint16_t(4),
+	Uint16_t(200), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(319), Uint16_t(1),
+	Anon_sym_DQUOTE2,
+	Uint16_t(71), Uint16_t(1),
+	Aux_sym__basic_string_repeat1,
+	Uint16_t(317), Uint16_t(2),
+	Aux_sym__basic_string_token1,
+	Sym_escape_sequence, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(321), Uint16_t(4),
+	Aux_sym_document_token1,
+	Anon_sym_RBRACK,
+	Anon_sym_COMMA,
+	Anon_sym_RBRACE, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(323), Uint16_t(4),
+	Aux_sym_document_token1,
+	Anon_sym_RBRACK,
+	Anon_sym_COMMA,
+	Anon_sym_RBRACE, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(325), Uint16_t(4),
+	Aux_sym_document_token1,
+	Anon_sym_RBRACK,
+	Anon_sym_COMMA,
+	Anon_sym_RBRACE, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(327), Uint16_t(4),
+	Aux_sym_document_token1,
+	Anon_sym_RBRACK,
+	Anon_sym_COMMA,
+	Anon_sym_RBRACE, Uint16_t(4),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(19), Uint16_t(1),
+	Aux_sym_document_token1,
+	Uint16_t(26), Uint16_t(1),
+	Aux_sym_array_repeat1,
+	Uint16_t(329), Uint16_t(2),
+	Anon_sym_RBRACK,
+	Anon_sym_COMMA, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(331), Uint16_t(4),
+	Aux_sym_document_token1,
+	Anon_sym_RBRACK,
+	Anon_sym_COMMA,
+	Anon_sym_RBRACE, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(333), Uint16_t(4),
+	Aux_sym_document_token1,
+	Anon_sym_RBRACK,
+	Anon_sym_COMMA,
+	Anon_sym_RBRACE, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(335), Uint16_t(4),
+	Aux_sym_document_token1,
+	Anon_sym_RBRACK,
+	Anon_sym_COMMA,
+	Anon_sym_RBRACE, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(337), Uint16_t(4),
+	Aux_sym_document_token1,
+	Anon_sym_RBRACK,
+	Anon_sym_COMMA,
+	Anon_sym_RBRACE, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(339), Uint16_t(4),
+	Aux_sym_document_token1,
+	Anon_sym_RBRACK,
+	Anon_sym_COMMA,
+	Anon_sym_RBRACE, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(341), Uint16_t(4),
+	Aux_sym_document_token1,
+	Anon_sym_RBRACK,
+	Anon_sym_COMMA,
+	Anon_sym_RBRACE, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(343), Uint16_t(4),
+	Aux_sym_document_token1,
+	Anon_sym_RBRACK,
+	Anon_sym_COMMA,
+	Anon_sym_RBRACE, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(345), Uint16_t(4),
+	Aux_sym_document_token1,
+	Anon_sym_RBRACK,
+	Anon_sym_COMMA,
+	Anon_sym_RBRACE, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(347), Uint16_t(4),
+	Aux_sym_document_token1,
+	Anon_sym_RBRACK,
+	Anon_sym_COMMA,
+	Anon_sym_RBRACE, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(349), Uint16_t(4),
+	Aux_sym_document_token1,
+	Anon_sym_RBRACK,
+	Anon_sym_COMMA,
+	Anon_sym_RBRACE, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(351), Uint16_t(4),
+	Aux_sym_document_token1,
+	Anon_sym_RBRACK,
+	Anon_sym_COMMA,
+	Anon_sym_RBRACE, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(353), Uint16_t(4),
+	Aux_sym_document_token1,
+	Anon_sym_RBRACK,
+	Anon_sym_COMMA,
+	Anon_sym_RBRACE, Uint16_t(4),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(355), Uint16_t(1),
+	Anon_sym_COMMA,
+	Uint16_t(357), Uint16_t(1),
+	Anon_sym_RBRACE,
+	Uint16_t(102), Uint16_t(1),
+	Aux_sym_inline_table_repeat1, Uint16_t(4),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(53), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(359), Uint16_t(1),
+	Anon_sym_COMMA,
+	Uint16_t(97), Uint16_t(1),
+	Aux_sym_array_repeat2, Uint16_t(4),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(73), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(257), Uint16_t(1),
+	Anon_sym_COMMA,
+	Uint16_t(97), Uint16_t(1),
+	Aux_sym_array_repeat2, Uint16_t(4),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(294), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(361), Uint16_t(1),
+	Anon_sym_COMMA,
+	Uint16_t(97), Uint16_t(1),
+	Aux_sym_array_repeat2, Uint16_t(4),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(65), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(364), Uint16_t(1),
+	Anon_sym_COMMA,
+	Uint16_t(97), Uint16_t(1),
+	Aux_sym_array_repeat2, Uint16_t(4),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(355), Uint16_t(1),
+	Anon_sym_COMMA,
+	Uint16_t(366), Uint16_t(1),
+	Anon_sym_RBRACE,
+	Uint16_t(107), Uint16_t(1),
+	Aux_sym_inline_table_repeat1, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(368), Uint16_t(3),
+	Anon_sym_RBRACK,
+	Anon_sym_EQ,
+	Anon_sym_DOT, Uint16_t(4),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(77), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(263), Uint16_t(1),
+	Anon_sym_COMMA,
+	Uint16_t(97), Uint16_t(1),
+	Aux_sym_array_repeat2, Uint16_t(4),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(355), Uint16_t(1),
+	Anon_sym_COMMA,
+	Uint16_t(370), Uint16_t(1),
+	Anon_sym_RBRACE,
+	Uint16_t(104), Uint16_t(1),
+	Aux_sym_inline_table_repeat1, Uint16_t(4),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(95), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(249), Uint16_t(1),
+	Anon_sym_COMMA,
+	Uint16_t(97), Uint16_t(1),
+	Aux_sym_array_repeat2, Uint16_t(4),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(372), Uint16_t(1),
+	Anon_sym_COMMA,
+	Uint16_t(375), Uint16_t(1),
+	Anon_sym_RBRACE,
+	Uint16_t(104), Uint16_t(1),
+	Aux_sym_inline_table_repeat1, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(377), Uint16_t(3),
+	Anon_sym_RBRACK,
+	Anon_sym_EQ,
+	Anon_sym_DOT, Uint16_t(4),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(69), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(284), Uint16_t(1),
+	Anon_sym_COMMA,
+	Uint16_t(97), Uint16_t(1),
+	Aux_sym_array_repeat2, Uint16_t(4),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(355), Uint16_t(1),
+	Anon_sym_COMMA,
+	Uint16_t(379), Uint16_t(1),
+	Anon_sym_RBRACE,
+	Uint16_t(104), Uint16_t(1),
+	Aux_sym_inline_table_repeat1, Uint16_t(3),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(381), Uint16_t(1),
+	Anon_sym_RBRACK_RBRACK,
+	Uint16_t(383), Uint16_t(1),
+	Anon_sym_DOT, Uint16_t(3),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(385), Uint16_t(1),
+	Anon_sym_RBRACK,
+	Uint16_t(387), Uint16_t(1),
+	Anon_sym_DOT, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(389), Uint16_t(2),
+	Anon_sym_COMMA,
+	Anon_sym_RBRACE, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(377), Uint16_t(2),
+	Anon_sym_RBRACK_RBRACK,
+	Anon_sym_DOT, Uint16_t(3),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(387), Uint16_t(1),
+	Anon_sym_DOT,
+	Uint16_t(391), Uint16_t(1),
+	Anon_sym_EQ, Uint16_t(3),
+	Uint16_t(200), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(393), Uint16_t(1),
+	Aux_sym__literal_string_token1,
+	Uint16_t(395), Uint16_t(1),
+	Anon_sym_SQUOTE2, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(397), Uint16_t(2),
+	Anon_sym_COMMA,
+	Anon_sym_RBRACE, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(245), Uint16_t(2),
+	Anon_sym_RBRACK_RBRACK,
+	Anon_sym_DOT, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(243), Uint16_t(2),
+	Anon_sym_RBRACK_RBRACK,
+	Anon_sym_DOT, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(229), Uint16_t(2),
+	Anon_sym_RBRACK_RBRACK,
+	Anon_sym_DOT, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(241), Uint16_t(2),
+	Anon_sym_RBRACK_RBRACK,
+	Anon_sym_DOT, Uint16_t(3),
+	Uint16_t(200), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(399), Uint16_t(1),
+	Aux_sym__literal_string_token1,
+	Uint16_t(401), Uint16_t(1),
+	Anon_sym_SQUOTE2, Uint16_t(3),
+	Uint16_t(200), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(403), Uint16_t(1),
+	Aux_sym__literal_string_token1,
+	Uint16_t(405), Uint16_t(1),
+	Anon_sym_SQUOTE2, Uint16_t(3),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(387), Uint16_t(1),
+	Anon_sym_DOT,
+	Uint16_t(407), Uint16_t(1),
+	Anon_sym_EQ, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(368), Uint16_t(2),
+	Anon_sym_RBRACK_RBRACK,
+	Anon_sym_DOT, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(333), Uint16_t(1),
+	Sym__line_ending_or_eof, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(353), Uint16_t(1),
+	Sym__line_ending_or_eof, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(351), Uint16_t(1),
+	Sym__line_ending_or_eof, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(339), Uint16_t(1),
+	Sym__line_ending_or_eof, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(389), Uint16_t(1),
+	Sym__line_ending_or_eof, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(335), Uint16_t(1),
+	Sym__line_ending_or_eof, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(241), Uint16_t(1),
+	Sym__line_ending_or_eof, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(229), Uint16_t(1),
+	Sym__line_ending_or_eof, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(341), Uint16_t(1),
+	Sym__line_ending_or_eof, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(409), Uint16_t(1),
+	Sym__line_ending_or_eof, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(411), Uint16_t(1),
+	Sym__line_ending_or_eof, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(349), Uint16_t(1),
+	Sym__line_ending_or_eof, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(413), Uint16_t(1),
+	Anon_sym_SQUOTE2, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(243), Uint16_t(1),
+	Sym__line_ending_or_eof, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(331), Uint16_t(1),
+	Sym__line_ending_or_eof, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(343), Uint16_t(1),
+	Sym__line_ending_or_eof, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(245), Uint16_t(1),
+	Sym__line_ending_or_eof, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(325), Uint16_t(1),
+	Sym__line_ending_or_eof, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(345), Uint16_t(1),
+	Sym__line_ending_or_eof, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(347), Uint16_t(1),
+	Sym__line_ending_or_eof, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(321), Uint16_t(1),
+	Sym__line_ending_or_eof, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(415), Uint16_t(1),
+	Sym__line_ending_or_eof, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(417), Uint16_t(1),
+	Uint16_t(Ts_builtin_sym_end), Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(419), Uint16_t(1),
+	Anon_sym_SQUOTE2, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(327), Uint16_t(1),
+	Sym__line_ending_or_eof, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(337), Uint16_t(1),
+	Sym__line_ending_or_eof, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(323), Uint16_t(1),
+	Sym__line_ending_or_eof, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(421), Uint16_t(1),
+	Anon_sym_SQUOTE2, Uint16_t(2),
+	Uint16_t(3), Uint16_t(1),
+	Sym_comment,
+	Uint16_t(290), Uint16_t(1),
+	Sym__line_ending_or_eof,
+} /* parser.c:1755:17 */
+
+var ts_small_parse_table_map = [150]Uint32_t{Uint32_t(0), Uint32_t(66), Uint32_t(132), Uint32_t(198), Uint32_t(264), Uint32_t(330), Uint32_t(396), Uint32_t(462), Uint32_t(528), Uint32_t(594), Uint32_t(660), Uint32_t(726), Uint32_t(792), Uint32_t(858), Uint32_t(924), Uint32_t(990), Uint32_t(1056), Uint32_t(1122), Uint32_t(1188), Uint32_t(1254), Uint32_t(1320), Uint32_t(1383), Uint32_t(1446), Uint32_t(1503), Uint32_t(1560), Uint32_t(1593), Uint32_t(1639), Uint32_t(1678), Uint32_t(1717), Uint32_t(1756), Uint32_t(1795), Uint32_t(1834), Uint32_t(1862), Uint32_t(1890), Uint32_t(1915), Uint32_t(1937), Uint32_t(1959), Uint32_t(1976), Uint32_t(1993), Uint32_t(2010), Uint32_t(2027), Uint32_t(2044), Uint32_t(2065), Uint32_t(2086), Uint32_t(2101), Uint32_t(2113), Uint32_t(2131), Uint32_t(2149), Uint32_t(2161), Uint32_t(2173), Uint32_t(2185), Uint32_t(2203), Uint32_t(2222), Uint32_t(2241), Uint32_t(2260), Uint32_t(2275), Uint32_t(2294), Uint32_t(2313), Uint32_t(2328), Uint32_t(2343), Uint32_t(2362), Uint32_t(2377), Uint32_t(2392), Uint32_t(2411), Uint32_t(2430), Uint32_t(2444), Uint32_t(2454), Uint32_t(2468), Uint32_t(2482), Uint32_t(2496), Uint32_t(2510), Uint32_t(2524), Uint32_t(2538), Uint32_t(2552), Uint32_t(2566), Uint32_t(2580), Uint32_t(2590), Uint32_t(2600), Uint32_t(2610), Uint32_t(2620), Uint32_t(2634), Uint32_t(2644), Uint32_t(2654), Uint32_t(2664), Uint32_t(2674), Uint32_t(2684), Uint32_t(2694), Uint32_t(2704), Uint32_t(2714), Uint32_t(2724), Uint32_t(2734), Uint32_t(2744), Uint32_t(2754), Uint32_t(2767), Uint32_t(2780), Uint32_t(2793), Uint32_t(2806), Uint32_t(2819), Uint32_t(2832), Uint32_t(2841), Uint32_t(2854), Uint32_t(2867), Uint32_t(2880), Uint32_t(2893), Uint32_t(2902), Uint32_t(2915), Uint32_t(2928), Uint32_t(2938), Uint32_t(2948), Uint32_t(2956), Uint32_t(2964), Uint32_t(2974), Uint32_t(2984), Uint32_t(2992), Uint32_t(3000), Uint32_t(3008), Uint32_t(3016), Uint32_t(3024), Uint32_t(3034), Uint32_t(3044), Uint32_t(3054), Uint32_t(3062), Uint32_t(3069), Uint32_t(3076), Uint32_t(3083), Uint32_t(3090), Uint32_t(3097), Uint32_t(3104), Uint32_t(3111), Uint32_t(3118), Uint32_t(3125), Uint32_t(3132), Uint32_t(3139), Uint32_t(3146), Uint32_t(3153), Uint32_t(3160), Uint32_t(3167), Uint32_t(3174), Uint32_t(3181), Uint32_t(3188), Uint32_t(3195), Uint32_t(3202), Uint32_t(3209), Uint32_t(3216), Uint32_t(3223), Uint32_t(3230), Uint32_t(3237), Uint32_t(3244), Uint32_t(3251), Uint32_t(3258)} /* parser.c:4164:17 */
+
+var ts_parse_actions = [423]TSParseActionEntry{{}, func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(False)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeRecover
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 4)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(0)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym_document
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(27)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(False)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(36)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(37)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(121)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(76)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(120)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(26)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(20)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(125)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(False)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(76)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(40)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(False)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(120)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(59)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(False)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(83)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(83)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(84)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(55)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(False)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(55)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(33)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(143)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(66)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(False)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(66)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(15)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(140)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(68)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(False)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(68)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(14)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(78)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(6)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(79)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(10)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(147)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(18)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(131)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(8)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(80)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(77)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(149)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(2)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(138)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(54)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(False)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(54)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(3)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(13)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(87)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(21)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(88)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(61)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(False)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(61)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(92)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(53)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(False)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(53)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(23)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(110)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(False)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(110)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(16)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(False)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(70)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(41)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(False)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(113)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(62)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(False)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(123)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(123)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(128)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(127)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(False)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(127)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(34)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(2)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(2)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Aux_sym_array_repeat1
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(26)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 5)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(2)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Aux_sym_array_repeat1
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(False)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(2)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Aux_sym_array_repeat1
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(1)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym_document
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(32)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(4)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym_table
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(31)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(False)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(4)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym_table
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(4)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym_table_array_element
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(30)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(False)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(4)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym_table_array_element
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(5)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym_table_array_element
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(False)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(5)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym_table_array_element
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(5)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym_table
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(False)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(5)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym_table
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(2)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Aux_sym_document_repeat1
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(2)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(2)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Aux_sym_document_repeat1
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(32)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 5)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(False)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(2)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Aux_sym_document_repeat1
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(2)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(2)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Aux_sym_document_repeat1
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(121)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 5)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(2)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(2)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Aux_sym_document_repeat1
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(76)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 5)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(2)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(2)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Aux_sym_document_repeat1
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(120)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 5)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(112)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(91)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(134)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(109)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(108)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(75)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(119)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(False)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 4)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(39)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(93)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(2)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(2)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Aux_sym__multiline_basic_string_repeat1
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(39)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 5)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(2)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Aux_sym__multiline_basic_string_repeat1
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(38)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(89)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(42)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(141)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(124)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(111)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(105)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(2)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym_pair
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(False)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(2)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym_pair
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(3)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym__basic_string
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(2)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Aux_sym_document_repeat2
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(2)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(False)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(2)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Aux_sym_document_repeat2
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(36)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 5)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(2)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(2)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Aux_sym_document_repeat2
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(37)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 5)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(2)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym_document
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(3)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym__literal_string
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(2)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym__literal_string
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(2)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym__basic_string
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(58)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(12)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(57)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(11)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(65)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(9)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(60)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(151)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(7)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(63)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(90)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(2)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(2)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Aux_sym__multiline_literal_string_repeat1
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(60)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 5)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(2)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Aux_sym__multiline_literal_string_repeat1
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(64)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(19)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(56)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(142)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(67)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(4)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(81)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(3)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Aux_sym_array_repeat2
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(3)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym__multiline_literal_string
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(74)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(2)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Aux_sym_array_repeat2
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(72)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(130)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(69)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(139)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(46)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(2)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(2)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Aux_sym__basic_string_repeat1
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(72)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 5)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(2)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Aux_sym__basic_string_repeat1
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(117)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(73)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(115)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(71)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(51)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(8)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym_array
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(7)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym_array
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(6)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym_array
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(5)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym_array
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(4)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Aux_sym_array_repeat2
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(4)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym_inline_table
+	*(*Uint16_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 6)) = Uint16_t(1)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(1)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym_integer
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(1)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym_float
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(3)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym_inline_table
+	*(*Uint16_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 6)) = Uint16_t(1)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(1)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym_string
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(4)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym_array
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(2)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym_array
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(2)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym__multiline_basic_string
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(2)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym__multiline_literal_string
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(2)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym_inline_table
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(3)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym_array
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(3)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym__multiline_basic_string
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(35)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(85)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(17)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(2)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(2)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Aux_sym_array_repeat2
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(22)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 5)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(5)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(148)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(1)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym_quoted_key
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(82)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(2)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(2)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Aux_sym_inline_table_repeat1
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(35)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 5)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(2)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Aux_sym_inline_table_repeat1
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(3)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym_dotted_key
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(137)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(132)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(43)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(133)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(44)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(3)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Sym__inline_pair
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(24)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(150)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(136)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeReduce
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = Uint8_t(2)
+	*(*TSSymbol)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = Aux_sym_inline_table_repeat1
+	*(*Uint16_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 6)) = Uint16_t(1)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(146)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(116)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(135)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(50)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(25)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(29)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(28)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(49)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(45)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeAccept
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(118)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = Uint8_t(1)
+	*(*uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 1)) = uint8(True)
+	return r
+}(), func() (r TSParseActionEntry) {
+	*(*Uint8_t)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 0)) = TSParseActionTypeShift
+	*(*TSStateId)(unsafe.Pointer(uintptr(unsafe.Pointer(&r)) + 2)) = TSStateId(129)
+	return r
+}()} /* parser.c:4317:27 */
+
+func Xtree_sitter_toml(tls *libc.TLS) uintptr { /* parser.c:4539:25: */
+	return uintptr(unsafe.Pointer(&language))
+}
+
+var language = TSLanguage{Version: Uint32_t(LANGUAGE_VERSION), Symbol_count: Uint32_t(SYMBOL_COUNT), Token_count: Uint32_t(TOKEN_COUNT), External_token_count: Uint32_t(EXTERNAL_TOKEN_COUNT), State_count: Uint32_t(STATE_COUNT), Large_state_count: Uint32_t(LARGE_STATE_COUNT), Production_id_count: Uint32_t(PRODUCTION_ID_COUNT), Max_alias_sequence_length: Uint16_t(MAX_ALIAS_SEQUENCE_LENGTH), Parse_table: 0, Small_parse_table: 0, Small_parse_table_map: 0, Parse_actions: 0, Symbol_names: 0, Symbol_metadata: 0, Public_symbol_map: 0, Alias_map: 0, Alias_sequences: 0, Lex_modes: 0, Lex_fn: 0, External_scanner: struct {
+	States      uintptr
+	Symbol_map  uintptr
+	Create      uintptr
+	Destroy     uintptr
+	Scan        uintptr
+	Serialize   uintptr
+	Deserialize uintptr
+}{
+	States:      0,
+	Symbol_map:  0,
+	Create:      0,
+	Destroy:     0,
+	Scan:        0,
+	Serialize:   0,
+	Deserialize: 0,
+},
+} /* parser.c:4540:21 */
+
+func Xtree_sitter_toml_external_scanner_create(tls *libc.TLS) uintptr { /* scanner.c:16:6: */
+	return uintptr(0)
+}
+
+func Xtree_sitter_toml_external_scanner_destroy(tls *libc.TLS, payload uintptr) { /* scanner.c:17:6: */
+}
+
+func Xtree_sitter_toml_external_scanner_serialize(tls *libc.TLS, payload uintptr, buffer uintptr) uint32 { /* scanner.c:18:10: */
+	return uint32(0)
+}
+
+func Xtree_sitter_toml_external_scanner_deserialize(tls *libc.TLS, payload uintptr, buffer uintptr, length uint32) { /* scanner.c:19:6: */
+}
+
+func Xtree_sitter_toml_external_scanner_scan_multiline_string_end(tls *libc.TLS, lexer uintptr, valid_symbols uintptr, delimiter Int32_t, content_symbol uint32, end_symbol uint32) uint8 { /* scanner.c:21:6: */
+	if !(int32(*(*uint8)(unsafe.Pointer(valid_symbols + uintptr(end_symbol)))) != 0) || (*TSLexer)(unsafe.Pointer(lexer)).Lookahead != delimiter {
+		return uint8(False)
+	}
+
+	(*struct {
+		f func(*libc.TLS, uintptr, uint8)
+	})(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Advance})).f(tls, lexer, uint8(False))
+	(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+
+	if (*TSLexer)(unsafe.Pointer(lexer)).Lookahead != delimiter {
+		(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = TSSymbol(content_symbol)
+		return uint8(True)
+	}
+
+	(*struct {
+		f func(*libc.TLS, uintptr, uint8)
+	})(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Advance})).f(tls, lexer, uint8(False))
+
+	if (*TSLexer)(unsafe.Pointer(lexer)).Lookahead != delimiter {
+		(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+		(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = TSSymbol(content_symbol)
+		return uint8(True)
+	}
+
+	(*struct {
+		f func(*libc.TLS, uintptr, uint8)
+	})(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Advance})).f(tls, lexer, uint8(False))
+
+	if (*TSLexer)(unsafe.Pointer(lexer)).Lookahead != delimiter {
+		(*struct{ f func(*libc.TLS, uintptr) })(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Mark_end})).f(tls, lexer)
+		(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = TSSymbol(end_symbol)
+		return uint8(True)
+	}
+
+	(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = TSSymbol(content_symbol)
+	return uint8(True)
+}
+
+func Xtree_sitter_toml_external_scanner_scan(tls *libc.TLS, payload uintptr, lexer uintptr, valid_symbols uintptr) uint8 { /* scanner.c:54:6: */
+	if Xtree_sitter_toml_external_scanner_scan_multiline_string_end(tls, lexer, valid_symbols, '"', MULTILINE_BASIC_STRING_CONTENT, MULTILINE_BASIC_STRING_END) != 0 ||
+		Xtree_sitter_toml_external_scanner_scan_multiline_string_end(tls, lexer, valid_symbols, '\'', MULTILINE_LITERAL_STRING_CONTENT, MULTILINE_LITERAL_STRING_END) != 0 {
+		return uint8(True)
+	}
+
+	if *(*uint8)(unsafe.Pointer(valid_symbols)) != 0 {
+		(*TSLexer)(unsafe.Pointer(lexer)).Result_symbol = LINE_ENDING_OR_EOF
+
+		for (*TSLexer)(unsafe.Pointer(lexer)).Lookahead == ' ' || (*TSLexer)(unsafe.Pointer(lexer)).Lookahead == '\t' {
+			(*struct {
+				f func(*libc.TLS, uintptr, uint8)
+			})(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Advance})).f(tls, lexer, uint8(True))
+		}
+
+		if (*TSLexer)(unsafe.Pointer(lexer)).Lookahead == 0 || (*TSLexer)(unsafe.Pointer(lexer)).Lookahead == '\n' {
+			return uint8(True)
+		}
+
+		if (*TSLexer)(unsafe.Pointer(lexer)).Lookahead == '\r' {
+			(*struct {
+				f func(*libc.TLS, uintptr, uint8)
+			})(unsafe.Pointer(&struct{ uintptr }{(*TSLexer)(unsafe.Pointer(lexer)).Advance})).f(tls, lexer, uint8(True))
+			if (*TSLexer)(unsafe.Pointer(lexer)).Lookahead == '\n' {
+				return uint8(True)
+			}
+		}
+	}
+
+	return uint8(False)
+}
+
+func init() {
+	*(*uintptr)(unsafe.Pointer(uintptr(unsafe.Pointer(&language)) + 40 /* .parse_table */)) = uintptr(uintptr(unsafe.Pointer(&ts_parse_table)))                     // parser.c:4551:20:
+	*(*uintptr)(unsafe.Pointer(uintptr(unsafe.Pointer(&language)) + 48 /* .small_parse_table */)) = uintptr(uintptr(unsafe.Pointer(&ts_small_parse_table)))         // parser.c:4552:26:
+	*(*uintptr)(unsafe.Pointer(uintptr(unsafe.Pointer(&language)) + 56 /* .small_parse_table_map */)) = uintptr(uintptr(unsafe.Pointer(&ts_small_parse_table_map))) // parser.c:4553:30:
+	*(*uintptr)(unsafe.Pointer(uintptr(unsafe.Pointer(&language)) + 64 /* .parse_actions */)) = uintptr(unsafe.Pointer(&ts_parse_actions))                          // parser.c:4554:22:
+	*(*uintptr)(unsafe.Pointer(uintptr(unsafe.Pointer(&language)) + 72 /* .symbol_names */)) = uintptr(unsafe.Pointer(&ts_symbol_names))                            // parser.c:4555:21:
+	*(*uintptr)(unsafe.Pointer(uintptr(unsafe.Pointer(&language)) + 104 /* .symbol_metadata */)) = uintptr(unsafe.Pointer(&ts_symbol_metadata))                     // parser.c:4556:24:
+	*(*uintptr)(unsafe.Pointer(uintptr(unsafe.Pointer(&language)) + 112 /* .public_symbol_map */)) = uintptr(unsafe.Pointer(&ts_symbol_map))                        // parser.c:4557:26:
+	*(*uintptr)(unsafe.Pointer(uintptr(unsafe.Pointer(&language)) + 120 /* .alias_map */)) = uintptr(unsafe.Pointer(&ts_non_terminal_alias_map))                    // parser.c:4558:18:
+	*(*uintptr)(unsafe.Pointer(uintptr(unsafe.Pointer(&language)) + 128 /* .alias_sequences */)) = uintptr(uintptr(unsafe.Pointer(&ts_alias_sequences)))            // parser.c:4559:24:
+	*(*uintptr)(unsafe.Pointer(uintptr(unsafe.Pointer(&language)) + 136 /* .lex_modes */)) = uintptr(unsafe.Pointer(&ts_lex_modes))                                 // parser.c:4560:18:
+	*(*func(*libc.TLS, uintptr, TSStateId) uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&language)) + 144)) = ts_lex                                                // parser.c:4561:15:
+	*(*uintptr)(unsafe.Pointer(uintptr(unsafe.Pointer(&language)) + 168 /* .states */)) = uintptr(uintptr(unsafe.Pointer(&ts_external_scanner_states)))             // parser.c:4563:7:
+	*(*uintptr)(unsafe.Pointer(uintptr(unsafe.Pointer(&language)) + 176 /* .symbol_map */)) = uintptr(unsafe.Pointer(&ts_external_scanner_symbol_map))              // parser.c:4564:7:
+	*(*func(*libc.TLS) uintptr)(unsafe.Pointer(uintptr(unsafe.Pointer(&language)) + 184)) = Xtree_sitter_toml_external_scanner_create                               // parser.c:4565:7:
+	*(*func(*libc.TLS, uintptr))(unsafe.Pointer(uintptr(unsafe.Pointer(&language)) + 192)) = Xtree_sitter_toml_external_scanner_destroy                             // parser.c:4566:7:
+	*(*func(*libc.TLS, uintptr, uintptr, uintptr) uint8)(unsafe.Pointer(uintptr(unsafe.Pointer(&language)) + 200)) = Xtree_sitter_toml_external_scanner_scan        // parser.c:4567:7:
+	*(*func(*libc.TLS, uintptr, uintptr) uint32)(unsafe.Pointer(uintptr(unsafe.Pointer(&language)) + 208)) = Xtree_sitter_toml_external_scanner_serialize           // parser.c:4568:7:
+	*(*func(*libc.TLS, uintptr, uintptr, uint32))(unsafe.Pointer(uintptr(unsafe.Pointer(&language)) + 216)) = Xtree_sitter_toml_external_scanner_deserialize        // parser.c:4569:7:
+}
+
+var ts1 = "end\x00document_token1\x00comment\x00[\x00]\x00[[\x00]]\x00=\x00.\x00bare_key\x00\"\x00_basic_string_token1\x00\"\"\"\x00_multiline_basic_string_token1\x00escape_sequence\x00'\x00_literal_string_token1\x00'''\x00integer_token1\x00integer_token2\x00integer_token3\x00integer_token4\x00float_token1\x00float_token2\x00boolean\x00offset_date_time\x00local_date_time\x00local_date\x00local_time\x00,\x00{\x00}\x00_line_ending_or_eof\x00_multiline_basic_string_content\x00_multiline_basic_string_end\x00_multiline_literal_string_content\x00_multiline_literal_string_end\x00document\x00table\x00table_array_element\x00pair\x00_inline_pair\x00_key\x00dotted_key\x00quoted_key\x00_inline_value\x00string\x00_basic_string\x00_multiline_basic_string\x00_literal_string\x00_multiline_literal_string\x00integer\x00float\x00array\x00inline_table\x00document_repeat1\x00document_repeat2\x00_basic_string_repeat1\x00_multiline_basic_string_repeat1\x00_multiline_literal_string_repeat1\x00array_repeat1\x00array_repeat2\x00inline_table_repeat1\x00"
+var ts = (*reflect.StringHeader)(unsafe.Pointer(&ts1)).Data
@@ -0,0 +1,12 @@
+// Code generated by 'ccgo -pkgname=tsx -export-defines  -export-enums  -export-externs X -export-structs S -export-fields  -export-typedefs  -trace-translation-units -o internal/tsx/tsx_linux_arm.go -I ./internal/lib -I ./internal/patch -I upstream/tree-sitter/lib/include upstream/tree-sitter-typescript/tsx/src/parser.c internal/tsx/patch/scanner.c', DO NOT EDIT.
+
+package tsx
+
+var CAPI = map[string]struct{}{
+	"tree_sitter_tsx":                              {},
+	"tree_sitter_tsx_external_scanner_create":      {},
+	"tree_sitter_tsx_external_scanner_deserialize": {},
+	"tree_sitter_tsx_external_scanner_destroy":     {},
+	"tree_sitter_tsx_external_scanner_scan":        {},
+	"tree_sitter_tsx_external_scanner_serialize":   {},
+}